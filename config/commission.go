@@ -0,0 +1,23 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+)
+
+type CommissionConfig struct {
+	Rate float64
+}
+
+func LoadCommissionConfig() CommissionConfig {
+	rate, err := strconv.ParseFloat(os.Getenv("COMMISSION_RATE"), 64)
+	if err != nil || rate < 0 || rate > 1 {
+		log.Print("Invalid commission rate. Default value will be used!")
+		rate = 0.1
+	}
+
+	return CommissionConfig{
+		Rate: rate,
+	}
+}
@@ -8,12 +8,18 @@ import (
 )
 
 type Config struct {
-	DB       DBConfig
-	Auth     AuthConfig
-	HTTP     HTTPConfig
-	SMTP     SMTPConfig
-	Email    EmailConfig
-	Midtrans MidtransConfig
+	DB             DBConfig
+	Auth           AuthConfig
+	HTTP           HTTPConfig
+	SMTP           SMTPConfig
+	Email          EmailConfig
+	Midtrans       MidtransConfig
+	Commission     CommissionConfig
+	Storage        StorageConfig
+	Search         SearchConfig
+	Moderation     ModerationConfig
+	Recommendation RecommendationConfig
+	Sentiment      SentimentConfig
 }
 
 func NewConfig() *Config {
@@ -25,11 +31,17 @@ func NewConfig() *Config {
 	}
 
 	return &Config{
-		DB:       LoadDBConfig(),
-		Auth:     LoadAuthConfig(),
-		HTTP:     LoadHTTPConfig(),
-		SMTP:     LoadSMTPConfig(),
-		Email:    LoadEmailConfig(),
-		Midtrans: LoadMidtransConfig(),
+		DB:             LoadDBConfig(),
+		Auth:           LoadAuthConfig(),
+		HTTP:           LoadHTTPConfig(),
+		SMTP:           LoadSMTPConfig(),
+		Email:          LoadEmailConfig(),
+		Midtrans:       LoadMidtransConfig(),
+		Commission:     LoadCommissionConfig(),
+		Storage:        LoadStorageConfig(),
+		Search:         LoadSearchConfig(),
+		Moderation:     LoadModerationConfig(),
+		Recommendation: LoadRecommendationConfig(),
+		Sentiment:      LoadSentimentConfig(),
 	}
 }
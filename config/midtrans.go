@@ -3,13 +3,29 @@ package config
 import "os"
 
 type MidtransConfig struct {
-	BaseURL   string
-	ServerKey string
+	BaseURL     string
+	SnapBaseURL string
+	ServerKey   string
+	Bank        string
+	Checkout    string
 }
 
 func LoadMidtransConfig() MidtransConfig {
+	bank := os.Getenv("MIDTRANS_BANK")
+	if bank == "" {
+		bank = "bca"
+	}
+
+	checkout := os.Getenv("MIDTRANS_CHECKOUT")
+	if checkout != "snap" {
+		checkout = "core"
+	}
+
 	return MidtransConfig{
-		BaseURL:   os.Getenv("MIDTRANS_BASE_URL"),
-		ServerKey: os.Getenv("MIDTRANS_SERVER_KEY"),
+		BaseURL:     os.Getenv("MIDTRANS_BASE_URL"),
+		SnapBaseURL: os.Getenv("MIDTRANS_SNAP_BASE_URL"),
+		ServerKey:   os.Getenv("MIDTRANS_SERVER_KEY"),
+		Bank:        bank,
+		Checkout:    checkout,
 	}
 }
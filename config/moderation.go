@@ -0,0 +1,21 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+type ModerationConfig struct {
+	BlockedWords []string
+}
+
+func LoadModerationConfig() ModerationConfig {
+	blockedWords := make([]string, 0)
+	if words := os.Getenv("MODERATION_BLOCKED_WORDS"); words != "" {
+		blockedWords = strings.Split(words, ",")
+	}
+
+	return ModerationConfig{
+		BlockedWords: blockedWords,
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+type RecommendationConfig struct {
+	RefreshInterval time.Duration
+}
+
+func LoadRecommendationConfig() RecommendationConfig {
+	interval, err := time.ParseDuration(os.Getenv("RECOMMENDATION_REFRESH_INTERVAL"))
+	if err != nil || interval < 0 {
+		log.Print("Invalid recommendation refresh interval. Default value will be used!")
+		interval = time.Hour
+	}
+
+	return RecommendationConfig{
+		RefreshInterval: interval,
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+type SearchConfig struct {
+	RebuildInterval time.Duration
+}
+
+func LoadSearchConfig() SearchConfig {
+	interval, err := time.ParseDuration(os.Getenv("SEARCH_REBUILD_INTERVAL"))
+	if err != nil || interval < 0 {
+		log.Print("Invalid search rebuild interval. Default value will be used!")
+		interval = 10 * time.Minute
+	}
+
+	return SearchConfig{
+		RebuildInterval: interval,
+	}
+}
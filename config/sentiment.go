@@ -0,0 +1,30 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+type SentimentConfig struct {
+	Analyzer     string
+	PollInterval time.Duration
+}
+
+func LoadSentimentConfig() SentimentConfig {
+	analyzer := os.Getenv("SENTIMENT_ANALYZER")
+	if analyzer == "" {
+		analyzer = "gcp"
+	}
+
+	interval, err := time.ParseDuration(os.Getenv("SENTIMENT_POLL_INTERVAL"))
+	if err != nil || interval <= 0 {
+		log.Print("Invalid sentiment poll interval. Default value will be used!")
+		interval = 10 * time.Second
+	}
+
+	return SentimentConfig{
+		Analyzer:     analyzer,
+		PollInterval: interval,
+	}
+}
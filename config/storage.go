@@ -0,0 +1,58 @@
+package config
+
+import "os"
+
+type StorageConfig struct {
+	Driver          string
+	LocalDir        string
+	BaseURL         string
+	PrivateLocalDir string
+	S3Endpoint      string
+	S3Region        string
+	S3Bucket        string
+	S3PrivateBucket string
+	S3AccessKey     string
+	S3SecretKey     string
+	S3PublicURL     string
+}
+
+func LoadStorageConfig() StorageConfig {
+	driver := os.Getenv("STORAGE_DRIVER")
+	if driver == "" {
+		driver = "local"
+	}
+
+	localDir := os.Getenv("STORAGE_LOCAL_DIR")
+	if localDir == "" {
+		localDir = "uploads"
+	}
+
+	baseURL := os.Getenv("STORAGE_BASE_URL")
+	if baseURL == "" {
+		baseURL = "/uploads"
+	}
+
+	privateLocalDir := os.Getenv("STORAGE_PRIVATE_LOCAL_DIR")
+	if privateLocalDir == "" {
+		privateLocalDir = "private"
+	}
+
+	s3Region := os.Getenv("STORAGE_S3_REGION")
+	if s3Region == "" {
+		s3Region = "us-east-1"
+	}
+
+	return StorageConfig{
+		Driver:          driver,
+		LocalDir:        localDir,
+		BaseURL:         baseURL,
+		PrivateLocalDir: privateLocalDir,
+		S3Endpoint:      os.Getenv("STORAGE_S3_ENDPOINT"),
+		S3Region:        s3Region,
+		S3Bucket:        os.Getenv("STORAGE_S3_BUCKET"),
+		S3PrivateBucket: os.Getenv("STORAGE_S3_PRIVATE_BUCKET"),
+		S3AccessKey:     os.Getenv("STORAGE_S3_ACCESS_KEY"),
+		S3SecretKey:     os.Getenv("STORAGE_S3_SECRET_KEY"),
+		S3PublicURL:     os.Getenv("STORAGE_S3_PUBLIC_URL"),
+	}
+}
@@ -1,33 +0,0 @@
-package helper
-
-import (
-	"context"
-
-	language "cloud.google.com/go/language/apiv1"
-	languagepb "google.golang.org/genproto/googleapis/cloud/language/v1"
-)
-
-func AnalyzeSentiment(text string) (float32, error) {
-	ctx := context.Background()
-
-	client, err := language.NewClient(ctx)
-	if err != nil {
-		return 0, err
-	}
-	defer client.Close()
-
-	sentiment, err := client.AnalyzeSentiment(ctx, &languagepb.AnalyzeSentimentRequest{
-		Document: &languagepb.Document{
-			Source: &languagepb.Document_Content{
-				Content: text,
-			},
-			Type: languagepb.Document_PLAIN_TEXT,
-		},
-		EncodingType: languagepb.EncodingType_UTF8,
-	})
-	if err != nil {
-		return 0, err
-	}
-
-	return sentiment.DocumentSentiment.Score, nil
-}
@@ -3,12 +3,15 @@ package helper
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/andikabahari/eoplatform/config"
+	"github.com/andikabahari/eoplatform/response"
 )
 
-func ChargeOrder(reqBody any) (*http.Response, error) {
+func ChargeOrder(reqBody any) (*response.MidtransChargeResponse, error) {
 	postBody, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
@@ -27,6 +30,16 @@ func ChargeOrder(reqBody any) (*http.Response, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
 
-	return res, nil
+	chargeResponse := response.MidtransChargeResponse{}
+	if err := json.NewDecoder(res.Body).Decode(&chargeResponse); err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(chargeResponse.StatusCode, "2") {
+		return nil, errors.New(chargeResponse.StatusMessage)
+	}
+
+	return &chargeResponse, nil
 }
@@ -0,0 +1,46 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/andikabahari/eoplatform/config"
+	"github.com/andikabahari/eoplatform/response"
+)
+
+func CreateSnapTransaction(reqBody any) (*response.MidtransSnapResponse, error) {
+	postBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	midtransConfig := config.LoadMidtransConfig()
+
+	req, err := http.NewRequest(http.MethodPost, midtransConfig.SnapBaseURL+"/snap/v1/transactions", bytes.NewReader(postBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", midtransConfig.ServerKey)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	snapResponse := response.MidtransSnapResponse{}
+	if err := json.NewDecoder(res.Body).Decode(&snapResponse); err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, errors.New(strings.Join(snapResponse.ErrorMessages, ", "))
+	}
+
+	return &snapResponse, nil
+}
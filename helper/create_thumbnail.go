@@ -0,0 +1,96 @@
+package helper
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+)
+
+// maxImagePixels keeps a small upload from decoding into a huge bitmap.
+const maxImagePixels = 40_000_000
+
+var ErrImageTooLarge = errors.New("image dimensions are too large")
+
+// CreateThumbnail decodes a JPEG or PNG image and returns it as a JPEG no
+// wider or taller than size, averaging the source pixels behind each
+// thumbnail pixel. Images already small enough are only re-encoded.
+func CreateThumbnail(r io.Reader, size int) ([]byte, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > size || height > size {
+		if width >= height {
+			height = height * size / width
+			width = size
+		} else {
+			width = width * size / height
+			height = size
+		}
+		if width < 1 {
+			width = 1
+		}
+		if height < 1 {
+			height = 1
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := bounds.Min.Y + (y+1)*bounds.Dy()/height
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := bounds.Min.X + (x+1)*bounds.Dx()/width
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					n++
+				}
+			}
+
+			// JPEG has no alpha channel, so transparent areas are
+			// flattened onto white.
+			background := 0xffff - a/n
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r/n + background),
+				G: uint16(g/n + background),
+				B: uint16(b/n + background),
+				A: 0xffff,
+			})
+		}
+	}
+
+	buf := bytes.Buffer{}
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,14 @@
+package helper
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+)
+
+// MidtransSignature is the signature_key Midtrans sends with a notification,
+// the hex SHA512 of its order_id, status_code and gross_amount followed by
+// the server key.
+func MidtransSignature(orderID, statusCode, grossAmount, serverKey string) string {
+	sum := sha512.Sum512([]byte(orderID + statusCode + grossAmount + serverKey))
+	return hex.EncodeToString(sum[:])
+}
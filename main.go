@@ -1,13 +1,55 @@
 package main
 
 import (
+	"flag"
+	"log"
+	"os"
+
 	"github.com/andikabahari/eoplatform/config"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/repository"
+	"github.com/andikabahari/eoplatform/request"
 	"github.com/andikabahari/eoplatform/server"
 	"github.com/andikabahari/eoplatform/server/route"
+	"github.com/andikabahari/eoplatform/usecase"
 )
 
 func main() {
+	createAdmin := flag.Bool("create-admin", false, "create an admin from ADMIN_NAME, ADMIN_USERNAME and ADMIN_PASSWORD, then exit")
+	flag.Parse()
+
 	app := server.NewServer(config.NewConfig())
+
+	if *createAdmin {
+		createAdminUser(app)
+		return
+	}
+
 	route.Setup(app)
 	app.Run()
 }
+
+// createAdminUser creates the admin account, which can't sign up through
+// the register endpoint.
+func createAdminUser(app *server.Server) {
+	req := request.CreateUserRequest{
+		Name:     os.Getenv("ADMIN_NAME"),
+		Username: os.Getenv("ADMIN_USERNAME"),
+		Password: os.Getenv("ADMIN_PASSWORD"),
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Fatalf("Error: %s", err)
+	}
+
+	req.Role = "admin"
+	user := model.User{}
+
+	registerUsecase := usecase.NewRegisterUsecase(repository.NewUserRepository(app.DB))
+	if apiError := registerUsecase.Register(&user, &req); apiError != nil {
+		_, message := apiError.APIError()
+		log.Fatalf("Error: %s", message)
+	}
+
+	log.Printf("Admin %s created", user.Username)
+}
@@ -0,0 +1,56 @@
+-- +goose Up
+CREATE TABLE `payouts` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `amount` double DEFAULT NULL,
+  `status` varchar(255),
+  `user_id` bigint unsigned DEFAULT NULL,
+  `bank_account_id` bigint unsigned DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_payouts_deleted_at` (`deleted_at`),
+  KEY `fk_payouts_user` (`user_id`),
+  KEY `fk_payouts_bank_account` (`bank_account_id`),
+  CONSTRAINT `fk_payouts_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+  CONSTRAINT `fk_payouts_bank_account` FOREIGN KEY (`bank_account_id`) REFERENCES `bank_accounts` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE `ledger_transactions` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `kind` varchar(255),
+  `order_id` bigint unsigned DEFAULT NULL,
+  `payout_id` bigint unsigned DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_ledger_transactions_deleted_at` (`deleted_at`),
+  KEY `fk_ledger_transactions_order` (`order_id`),
+  KEY `fk_ledger_transactions_payout` (`payout_id`),
+  CONSTRAINT `fk_ledger_transactions_order` FOREIGN KEY (`order_id`) REFERENCES `orders` (`id`),
+  CONSTRAINT `fk_ledger_transactions_payout` FOREIGN KEY (`payout_id`) REFERENCES `payouts` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE `ledger_entries` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `ledger_transaction_id` bigint unsigned DEFAULT NULL,
+  `account` varchar(255),
+  `user_id` bigint unsigned DEFAULT NULL,
+  `amount` double DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_ledger_entries_deleted_at` (`deleted_at`),
+  KEY `idx_ledger_entries_account_user` (`account`,`user_id`),
+  KEY `fk_ledger_entries_ledger_transaction` (`ledger_transaction_id`),
+  KEY `fk_ledger_entries_user` (`user_id`),
+  CONSTRAINT `fk_ledger_entries_ledger_transaction` FOREIGN KEY (`ledger_transaction_id`) REFERENCES `ledger_transactions` (`id`),
+  CONSTRAINT `fk_ledger_entries_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- +goose Down
+DROP TABLE IF EXISTS `ledger_entries`;
+DROP TABLE IF EXISTS `ledger_transactions`;
+DROP TABLE IF EXISTS `payouts`;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE `commission_rates` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `scope` varchar(255),
+  `scope_id` bigint unsigned DEFAULT NULL,
+  `rate` double DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_commission_rates_scope` (`scope`,`scope_id`),
+  KEY `idx_commission_rates_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+ALTER TABLE `payments`
+  ADD COLUMN `commission` double DEFAULT NULL AFTER `amount`,
+  ADD COLUMN `earning` double DEFAULT NULL AFTER `commission`;
+
+UPDATE `payments` SET `commission` = 0, `earning` = `amount` WHERE `status` = 'success';
+
+-- +goose Down
+ALTER TABLE `payments`
+  DROP COLUMN `earning`,
+  DROP COLUMN `commission`;
+
+DROP TABLE IF EXISTS `commission_rates`;
@@ -0,0 +1,35 @@
+-- +goose Up
+ALTER TABLE `orders`
+  ADD COLUMN `payment_method` varchar(255) AFTER `note`,
+  ADD COLUMN `bank` varchar(255) AFTER `payment_method`;
+
+UPDATE `orders` SET `payment_method` = 'bank_transfer';
+
+ALTER TABLE `payments`
+  ADD COLUMN `method` varchar(255) AFTER `status`,
+  ADD COLUMN `bank` varchar(255) AFTER `method`,
+  ADD COLUMN `va_number` varchar(255) AFTER `bank`,
+  ADD COLUMN `bill_key` varchar(255) AFTER `va_number`,
+  ADD COLUMN `biller_code` varchar(255) AFTER `bill_key`,
+  ADD COLUMN `qr_string` text AFTER `biller_code`,
+  ADD COLUMN `qr_url` varchar(255) AFTER `qr_string`,
+  ADD COLUMN `deeplink` varchar(255) AFTER `qr_url`,
+  ADD COLUMN `expiry_time` varchar(255) AFTER `deeplink`;
+
+UPDATE `payments` SET `method` = 'bank_transfer';
+
+-- +goose Down
+ALTER TABLE `payments`
+  DROP COLUMN `expiry_time`,
+  DROP COLUMN `deeplink`,
+  DROP COLUMN `qr_url`,
+  DROP COLUMN `qr_string`,
+  DROP COLUMN `biller_code`,
+  DROP COLUMN `bill_key`,
+  DROP COLUMN `va_number`,
+  DROP COLUMN `bank`,
+  DROP COLUMN `method`;
+
+ALTER TABLE `orders`
+  DROP COLUMN `bank`,
+  DROP COLUMN `payment_method`;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE `payments`
+  ADD COLUMN `snap_token` varchar(255) AFTER `expiry_time`,
+  ADD COLUMN `redirect_url` varchar(255) AFTER `snap_token`;
+
+-- +goose Down
+ALTER TABLE `payments`
+  DROP COLUMN `redirect_url`,
+  DROP COLUMN `snap_token`;
@@ -0,0 +1,28 @@
+-- +goose Up
+UPDATE `services` SET `cost` = ROUND(`cost`);
+UPDATE `payments` SET `amount` = ROUND(`amount`), `commission` = ROUND(`commission`), `earning` = ROUND(`earning`);
+UPDATE `payouts` SET `amount` = ROUND(`amount`);
+UPDATE `ledger_entries` SET `amount` = ROUND(`amount`);
+
+ALTER TABLE `services` MODIFY `cost` bigint DEFAULT NULL;
+
+ALTER TABLE `payments`
+  MODIFY `amount` bigint DEFAULT NULL,
+  MODIFY `commission` bigint DEFAULT NULL,
+  MODIFY `earning` bigint DEFAULT NULL;
+
+ALTER TABLE `payouts` MODIFY `amount` bigint DEFAULT NULL;
+
+ALTER TABLE `ledger_entries` MODIFY `amount` bigint DEFAULT NULL;
+
+-- +goose Down
+ALTER TABLE `ledger_entries` MODIFY `amount` double DEFAULT NULL;
+
+ALTER TABLE `payouts` MODIFY `amount` double DEFAULT NULL;
+
+ALTER TABLE `payments`
+  MODIFY `amount` double DEFAULT NULL,
+  MODIFY `commission` double DEFAULT NULL,
+  MODIFY `earning` double DEFAULT NULL;
+
+ALTER TABLE `services` MODIFY `cost` double DEFAULT NULL;
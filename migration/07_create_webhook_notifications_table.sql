@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE `webhook_notifications` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `transaction_id` varchar(255),
+  `transaction_status` varchar(255),
+  `order_id` varchar(255),
+  `payment_type` varchar(255),
+  `status` varchar(255),
+  `error` varchar(255),
+  `attempts` bigint DEFAULT NULL,
+  `processed_at` datetime(3) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_webhook_notifications_key` (`transaction_id`,`transaction_status`),
+  KEY `idx_webhook_notifications_status` (`status`),
+  KEY `idx_webhook_notifications_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- +goose Down
+DROP TABLE IF EXISTS `webhook_notifications`;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE `payments`
+  ADD COLUMN `receipt_key` varchar(255) AFTER `redirect_url`,
+  ADD COLUMN `receipt_url` varchar(255) AFTER `receipt_key`,
+  ADD COLUMN `receipt_note` varchar(300) AFTER `receipt_url`;
+
+-- +goose Down
+ALTER TABLE `payments`
+  DROP COLUMN `receipt_note`,
+  DROP COLUMN `receipt_url`,
+  DROP COLUMN `receipt_key`;
@@ -0,0 +1,57 @@
+-- +goose Up
+CREATE TABLE `categories` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `name` varchar(255),
+  `slug` varchar(255),
+  `parent_id` bigint unsigned DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_categories_slug` (`slug`),
+  KEY `idx_categories_deleted_at` (`deleted_at`),
+  KEY `fk_categories_parent` (`parent_id`),
+  CONSTRAINT `fk_categories_parent` FOREIGN KEY (`parent_id`) REFERENCES `categories` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE `tags` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `name` varchar(255),
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_tags_name` (`name`),
+  KEY `idx_tags_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE `service_tags` (
+  `service_id` bigint unsigned NOT NULL,
+  `tag_id` bigint unsigned NOT NULL,
+  PRIMARY KEY (`service_id`,`tag_id`),
+  KEY `fk_service_tags_tag` (`tag_id`),
+  CONSTRAINT `fk_service_tags_service` FOREIGN KEY (`service_id`) REFERENCES `services` (`id`),
+  CONSTRAINT `fk_service_tags_tag` FOREIGN KEY (`tag_id`) REFERENCES `tags` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+ALTER TABLE `services`
+  ADD COLUMN `category_id` bigint unsigned DEFAULT NULL AFTER `user_id`,
+  ADD KEY `fk_services_category` (`category_id`),
+  ADD CONSTRAINT `fk_services_category` FOREIGN KEY (`category_id`) REFERENCES `categories` (`id`);
+
+INSERT INTO `categories` (`created_at`, `updated_at`, `name`, `slug`) VALUES
+  (NOW(3), NOW(3), 'Venue', 'venue'),
+  (NOW(3), NOW(3), 'Catering', 'catering'),
+  (NOW(3), NOW(3), 'Photography', 'photography'),
+  (NOW(3), NOW(3), 'Decoration', 'decoration'),
+  (NOW(3), NOW(3), 'Entertainment', 'entertainment');
+
+-- +goose Down
+ALTER TABLE `services`
+  DROP FOREIGN KEY `fk_services_category`,
+  DROP KEY `fk_services_category`,
+  DROP COLUMN `category_id`;
+
+DROP TABLE IF EXISTS `service_tags`;
+DROP TABLE IF EXISTS `tags`;
+DROP TABLE IF EXISTS `categories`;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE `service_images` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `service_id` bigint unsigned DEFAULT NULL,
+  `key` varchar(255),
+  `url` varchar(255),
+  `thumbnail_key` varchar(255),
+  `thumbnail_url` varchar(255),
+  `caption` varchar(200),
+  `position` bigint,
+  `is_cover` tinyint(1) DEFAULT 0,
+  PRIMARY KEY (`id`),
+  KEY `idx_service_images_deleted_at` (`deleted_at`),
+  KEY `fk_services_images` (`service_id`),
+  CONSTRAINT `fk_services_images` FOREIGN KEY (`service_id`) REFERENCES `services` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- +goose Down
+DROP TABLE IF EXISTS `service_images`;
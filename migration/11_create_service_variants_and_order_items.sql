@@ -0,0 +1,44 @@
+-- +goose Up
+CREATE TABLE `service_variants` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `service_id` bigint unsigned DEFAULT NULL,
+  `name` varchar(50),
+  `price` bigint DEFAULT NULL,
+  `description` varchar(500),
+  `items` json,
+  PRIMARY KEY (`id`),
+  KEY `idx_service_variants_deleted_at` (`deleted_at`),
+  KEY `fk_services_variants` (`service_id`),
+  CONSTRAINT `fk_services_variants` FOREIGN KEY (`service_id`) REFERENCES `services` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+CREATE TABLE `order_items` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `order_id` bigint unsigned DEFAULT NULL,
+  `service_id` bigint unsigned DEFAULT NULL,
+  `variant_id` bigint unsigned DEFAULT NULL,
+  `kind` varchar(20),
+  `name` varchar(255),
+  `price` bigint DEFAULT NULL,
+  `quantity` bigint DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_order_items_deleted_at` (`deleted_at`),
+  KEY `fk_orders_items` (`order_id`),
+  CONSTRAINT `fk_orders_items` FOREIGN KEY (`order_id`) REFERENCES `orders` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+INSERT INTO `order_items` (`created_at`, `updated_at`, `order_id`, `service_id`, `kind`, `name`, `price`, `quantity`)
+SELECT o.`created_at`, o.`created_at`, os.`order_id`, os.`service_id`, 'service', s.`name`, s.`cost`, 1
+FROM `order_services` os
+JOIN `orders` o ON o.`id`=os.`order_id`
+JOIN `services` s ON s.`id`=os.`service_id`;
+
+-- +goose Down
+DROP TABLE IF EXISTS `order_items`;
+DROP TABLE IF EXISTS `service_variants`;
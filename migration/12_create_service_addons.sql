@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE `service_addons` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `service_id` bigint unsigned DEFAULT NULL,
+  `name` varchar(50),
+  `price` bigint DEFAULT NULL,
+  `max_quantity` bigint DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_service_addons_deleted_at` (`deleted_at`),
+  KEY `fk_services_addons` (`service_id`),
+  CONSTRAINT `fk_services_addons` FOREIGN KEY (`service_id`) REFERENCES `services` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+ALTER TABLE `order_items` ADD COLUMN `addon_id` bigint unsigned DEFAULT NULL AFTER `variant_id`;
+
+-- +goose Down
+ALTER TABLE `order_items` DROP COLUMN `addon_id`;
+DROP TABLE IF EXISTS `service_addons`;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE `services` ADD COLUMN `status` varchar(20) NOT NULL DEFAULT 'published' AFTER `description`;
+CREATE INDEX `idx_services_status` ON `services` (`status`);
+
+-- Services deleted before statuses existed are brought back as archived when
+-- orders still refer to them.
+UPDATE `services` SET `status`='archived', `deleted_at`=NULL
+WHERE `deleted_at` IS NOT NULL AND `id` IN (SELECT `service_id` FROM `order_services`);
+
+-- +goose Down
+UPDATE `services` SET `deleted_at`=CURRENT_TIMESTAMP(3) WHERE `status`='archived';
+DROP INDEX `idx_services_status` ON `services`;
+ALTER TABLE `services` DROP COLUMN `status`;
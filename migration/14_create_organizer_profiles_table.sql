@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE `organizer_profiles` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `user_id` bigint unsigned DEFAULT NULL,
+  `display_name` varchar(100),
+  `bio` varchar(1000),
+  `city` varchar(100),
+  `logo_url` varchar(500),
+  `phone` varchar(20),
+  `email` varchar(255),
+  `website` varchar(255),
+  `social_links` json,
+  `founded_year` bigint DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_organizer_profiles_user_id` (`user_id`),
+  KEY `idx_organizer_profiles_deleted_at` (`deleted_at`),
+  CONSTRAINT `fk_organizer_profiles_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- +goose Down
+DROP TABLE IF EXISTS `organizer_profiles`;
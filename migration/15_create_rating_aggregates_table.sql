@@ -0,0 +1,62 @@
+-- +goose Up
+CREATE TABLE `rating_aggregates` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `rated_type` varchar(20) NOT NULL,
+  `rated_id` bigint unsigned NOT NULL,
+  `count` bigint NOT NULL DEFAULT 0,
+  `total` bigint NOT NULL DEFAULT 0,
+  `star1` bigint NOT NULL DEFAULT 0,
+  `star2` bigint NOT NULL DEFAULT 0,
+  `star3` bigint NOT NULL DEFAULT 0,
+  `star4` bigint NOT NULL DEFAULT 0,
+  `star5` bigint NOT NULL DEFAULT 0,
+  `positive_count` bigint NOT NULL DEFAULT 0,
+  `negative_count` bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_rating_aggregates_rated` (`rated_type`,`rated_id`),
+  KEY `idx_rating_aggregates_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+INSERT INTO `rating_aggregates` (`created_at`, `updated_at`, `rated_type`, `rated_id`,
+  `count`, `total`, `star1`, `star2`, `star3`, `star4`, `star5`, `positive_count`, `negative_count`)
+SELECT NOW(3), NOW(3), 'organizer', `to_user_id`,
+  COUNT(1), SUM(`rating`), SUM(`rating`=1), SUM(`rating`=2), SUM(`rating`=3), SUM(`rating`=4), SUM(`rating`=5),
+  SUM(`positive`>0), SUM(`negative`>0)
+FROM `feedbacks`
+WHERE `deleted_at` IS NULL
+GROUP BY `to_user_id`;
+
+-- A feedback is counted for the services of the customer's completed order
+-- with the organizer that it pairs with: the first feedback with the first
+-- order, the second with the second, and so on.
+INSERT INTO `rating_aggregates` (`created_at`, `updated_at`, `rated_type`, `rated_id`,
+  `count`, `total`, `star1`, `star2`, `star3`, `star4`, `star5`, `positive_count`, `negative_count`)
+SELECT NOW(3), NOW(3), 'service', os.`service_id`,
+  COUNT(1), SUM(f.`rating`), SUM(f.`rating`=1), SUM(f.`rating`=2), SUM(f.`rating`=3), SUM(f.`rating`=4), SUM(f.`rating`=5),
+  SUM(f.`positive`>0), SUM(f.`negative`>0)
+FROM (
+  SELECT `from_user_id`, `to_user_id`, `rating`, `positive`, `negative`,
+    ROW_NUMBER() OVER (PARTITION BY `from_user_id`, `to_user_id` ORDER BY `id`) AS n
+  FROM `feedbacks`
+  WHERE `deleted_at` IS NULL
+) f
+JOIN (
+  SELECT t.`id`, t.`user_id`, t.`organizer_id`,
+    ROW_NUMBER() OVER (PARTITION BY t.`user_id`, t.`organizer_id` ORDER BY t.`id`) AS n
+  FROM (
+    SELECT DISTINCT o.`id`, o.`user_id`, s.`user_id` AS `organizer_id`
+    FROM `orders` o
+    JOIN `order_services` os ON os.`order_id`=o.`id`
+    JOIN `services` s ON s.`id`=os.`service_id`
+    WHERE o.`is_completed`>0
+  ) t
+) o ON o.`user_id`=f.`from_user_id` AND o.`organizer_id`=f.`to_user_id` AND o.n=f.n
+JOIN `order_services` os ON os.`order_id`=o.`id`
+JOIN `services` s ON s.`id`=os.`service_id` AND s.`user_id`=f.`to_user_id`
+GROUP BY os.`service_id`;
+
+-- +goose Down
+DROP TABLE IF EXISTS `rating_aggregates`;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE `service_questions` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `service_id` bigint unsigned NOT NULL,
+  `user_id` bigint unsigned NOT NULL,
+  `question` varchar(1000) NOT NULL,
+  `answer` varchar(2000) NOT NULL DEFAULT '',
+  `answered_at` datetime(3) DEFAULT NULL,
+  `status` varchar(20) NOT NULL DEFAULT 'published',
+  PRIMARY KEY (`id`),
+  KEY `idx_service_questions_service_status` (`service_id`,`status`),
+  KEY `idx_service_questions_deleted_at` (`deleted_at`),
+  CONSTRAINT `fk_service_questions_service` FOREIGN KEY (`service_id`) REFERENCES `services` (`id`),
+  CONSTRAINT `fk_service_questions_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- +goose Down
+DROP TABLE IF EXISTS `service_questions`;
@@ -0,0 +1,45 @@
+-- +goose Up
+CREATE TABLE `service_revisions` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `service_id` bigint unsigned NOT NULL,
+  `number` bigint NOT NULL,
+  `user_id` bigint unsigned DEFAULT NULL,
+  `name` varchar(255),
+  `cost` bigint DEFAULT NULL,
+  `phone` varchar(20),
+  `email` varchar(255),
+  `description` varchar(500),
+  `status` varchar(20),
+  `category_id` bigint unsigned DEFAULT NULL,
+  `tags` json,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_service_revisions_service_number` (`service_id`,`number`),
+  KEY `idx_service_revisions_deleted_at` (`deleted_at`),
+  CONSTRAINT `fk_service_revisions_service` FOREIGN KEY (`service_id`) REFERENCES `services` (`id`),
+  CONSTRAINT `fk_service_revisions_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+ALTER TABLE `order_items` ADD COLUMN `service_revision_id` bigint unsigned DEFAULT NULL AFTER `service_id`;
+ALTER TABLE `order_items` ADD CONSTRAINT `fk_order_items_service_revision` FOREIGN KEY (`service_revision_id`) REFERENCES `service_revisions` (`id`);
+
+-- Existing services start their history from what they look like now. Their
+-- past orders are not linked, since what was current then is not known.
+INSERT INTO `service_revisions` (`created_at`, `updated_at`, `service_id`, `number`, `user_id`,
+  `name`, `cost`, `phone`, `email`, `description`, `status`, `category_id`, `tags`)
+SELECT NOW(3), NOW(3), s.`id`, 1, s.`user_id`,
+  s.`name`, s.`cost`, s.`phone`, s.`email`, s.`description`, s.`status`, s.`category_id`,
+  COALESCE((
+    SELECT JSON_ARRAYAGG(t.`name`) FROM `service_tags` st
+    JOIN `tags` t ON t.`id`=st.`tag_id`
+    WHERE st.`service_id`=s.`id`
+  ), JSON_ARRAY())
+FROM `services` s
+WHERE s.`deleted_at` IS NULL;
+
+-- +goose Down
+ALTER TABLE `order_items` DROP FOREIGN KEY `fk_order_items_service_revision`;
+ALTER TABLE `order_items` DROP COLUMN `service_revision_id`;
+DROP TABLE IF EXISTS `service_revisions`;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE `service_cooccurrences` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `deleted_at` datetime(3) DEFAULT NULL,
+  `service_id` bigint unsigned NOT NULL,
+  `related_service_id` bigint unsigned NOT NULL,
+  `count` bigint NOT NULL DEFAULT '0',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_service_cooccurrences_pair` (`service_id`,`related_service_id`),
+  KEY `idx_service_cooccurrences_deleted_at` (`deleted_at`),
+  CONSTRAINT `fk_service_cooccurrences_service` FOREIGN KEY (`service_id`) REFERENCES `services` (`id`),
+  CONSTRAINT `fk_service_cooccurrences_related_service` FOREIGN KEY (`related_service_id`) REFERENCES `services` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- +goose Down
+DROP TABLE IF EXISTS `service_cooccurrences`;
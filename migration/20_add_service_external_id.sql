@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE `services` ADD COLUMN `external_id` varchar(100) DEFAULT NULL AFTER `status`;
+CREATE UNIQUE INDEX `idx_services_user_external` ON `services` (`user_id`,`external_id`);
+
+-- +goose Down
+DROP INDEX `idx_services_user_external` ON `services`;
+ALTER TABLE `services` DROP COLUMN `external_id`;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Feedbacks written so far were scored when they were created.
+ALTER TABLE `feedbacks`
+  ADD COLUMN `sentiment_status` varchar(20) NOT NULL DEFAULT 'scored' AFTER `negative`,
+  ADD COLUMN `sentiment_attempts` bigint NOT NULL DEFAULT 0 AFTER `sentiment_status`,
+  ADD COLUMN `sentiment_retry_at` datetime(3) DEFAULT NULL AFTER `sentiment_attempts`;
+CREATE INDEX `idx_feedbacks_sentiment` ON `feedbacks` (`sentiment_status`,`sentiment_retry_at`);
+
+-- +goose Down
+DROP INDEX `idx_feedbacks_sentiment` ON `feedbacks`;
+ALTER TABLE `feedbacks`
+  DROP COLUMN `sentiment_retry_at`,
+  DROP COLUMN `sentiment_attempts`,
+  DROP COLUMN `sentiment_status`;
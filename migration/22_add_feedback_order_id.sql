@@ -0,0 +1,34 @@
+-- +goose Up
+ALTER TABLE `feedbacks`
+  ADD COLUMN `order_id` bigint unsigned DEFAULT NULL AFTER `to_user_id`,
+  ADD UNIQUE KEY `idx_feedbacks_order_id` (`order_id`),
+  ADD CONSTRAINT `fk_feedbacks_order` FOREIGN KEY (`order_id`) REFERENCES `orders` (`id`);
+
+-- Feedbacks written so far pair with the customer's completed orders with
+-- the organizer: the first feedback with the first order, the second with
+-- the second, and so on.
+UPDATE `feedbacks` f
+JOIN (
+  SELECT `id`, `from_user_id`, `to_user_id`,
+    ROW_NUMBER() OVER (PARTITION BY `from_user_id`, `to_user_id` ORDER BY `id`) AS n
+  FROM `feedbacks`
+  WHERE `deleted_at` IS NULL
+) fn ON fn.`id`=f.`id`
+JOIN (
+  SELECT t.`id`, t.`user_id`, t.`organizer_id`,
+    ROW_NUMBER() OVER (PARTITION BY t.`user_id`, t.`organizer_id` ORDER BY t.`id`) AS n
+  FROM (
+    SELECT DISTINCT o.`id`, o.`user_id`, s.`user_id` AS `organizer_id`
+    FROM `orders` o
+    JOIN `order_services` os ON os.`order_id`=o.`id`
+    JOIN `services` s ON s.`id`=os.`service_id`
+    WHERE o.`is_completed`>0
+  ) t
+) o ON o.`user_id`=fn.`from_user_id` AND o.`organizer_id`=fn.`to_user_id` AND o.n=fn.n
+SET f.`order_id`=o.`id`;
+
+-- +goose Down
+ALTER TABLE `feedbacks`
+  DROP FOREIGN KEY `fk_feedbacks_order`,
+  DROP INDEX `idx_feedbacks_order_id`,
+  DROP COLUMN `order_id`;
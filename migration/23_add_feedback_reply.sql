@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE `feedbacks`
+  ADD COLUMN `reply` varchar(2000) NOT NULL DEFAULT '' AFTER `rating`,
+  ADD COLUMN `replied_at` datetime(3) DEFAULT NULL AFTER `reply`;
+
+-- +goose Down
+ALTER TABLE `feedbacks`
+  DROP COLUMN `replied_at`,
+  DROP COLUMN `reply`;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Receipts are no longer served from public storage, only through the
+-- order's receipt endpoint.
+UPDATE `payments`
+SET `receipt_url` = CONCAT('/v1/orders/', `order_id`, '/receipt')
+WHERE `receipt_key` <> '';
+
+-- +goose Down
+-- The public receipt URLs are not restored, since receipts are no longer
+-- kept in public storage.
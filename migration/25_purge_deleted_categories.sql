@@ -0,0 +1,21 @@
+-- +goose Up
+-- Categories are now deleted for good, so the ones deleted before are
+-- purged too, freeing their slugs. Their commission rates go with them.
+UPDATE `services` s
+JOIN `categories` c ON c.`id` = s.`category_id`
+SET s.`category_id` = NULL
+WHERE c.`deleted_at` IS NOT NULL;
+
+UPDATE `categories`
+SET `parent_id` = NULL
+WHERE `deleted_at` IS NOT NULL;
+
+DELETE r FROM `commission_rates` r
+JOIN `categories` c ON c.`id` = r.`scope_id`
+WHERE r.`scope` = 'category' AND c.`deleted_at` IS NOT NULL;
+
+DELETE FROM `categories`
+WHERE `deleted_at` IS NOT NULL;
+
+-- +goose Down
+-- The purged categories are not restored.
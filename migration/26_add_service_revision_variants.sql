@@ -0,0 +1,29 @@
+-- +goose Up
+ALTER TABLE `service_revisions`
+  ADD COLUMN `variants` json AFTER `tags`,
+  ADD COLUMN `addons` json AFTER `variants`;
+
+-- The latest revision of each service takes the variants and add-ons it has
+-- now. Earlier revisions are left without, since what they had is not known.
+UPDATE `service_revisions` r
+JOIN (
+  SELECT `service_id`, MAX(`number`) AS `number` FROM `service_revisions` GROUP BY `service_id`
+) latest ON latest.`service_id`=r.`service_id` AND latest.`number`=r.`number`
+SET r.`variants` = COALESCE((
+    SELECT JSON_ARRAYAGG(JSON_OBJECT(
+      'id', v.`id`, 'name', v.`name`, 'price', v.`price`,
+      'description', v.`description`, 'items', v.`items`
+    )) FROM `service_variants` v
+    WHERE v.`service_id`=r.`service_id` AND v.`deleted_at` IS NULL
+  ), JSON_ARRAY()),
+  r.`addons` = COALESCE((
+    SELECT JSON_ARRAYAGG(JSON_OBJECT(
+      'id', a.`id`, 'name', a.`name`, 'price', a.`price`, 'max_quantity', a.`max_quantity`
+    )) FROM `service_addons` a
+    WHERE a.`service_id`=r.`service_id` AND a.`deleted_at` IS NULL
+  ), JSON_ARRAY());
+
+-- +goose Down
+ALTER TABLE `service_revisions`
+  DROP COLUMN `addons`,
+  DROP COLUMN `variants`;
@@ -0,0 +1,10 @@
+package model
+
+import "gorm.io/gorm"
+
+type Category struct {
+	gorm.Model
+	Name     string
+	Slug     string `gorm:"uniqueIndex"`
+	ParentID *uint
+}
@@ -0,0 +1,15 @@
+package model
+
+import "gorm.io/gorm"
+
+const (
+	CommissionScopeOrganizer = "organizer"
+	CommissionScopeCategory  = "category"
+)
+
+type CommissionRate struct {
+	gorm.Model
+	Scope   string
+	ScopeID uint
+	Rate    float64
+}
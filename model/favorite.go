@@ -0,0 +1,10 @@
+package model
+
+import "gorm.io/gorm"
+
+// Favorite is a service a customer saved to compare later.
+type Favorite struct {
+	gorm.Model
+	UserID    uint `gorm:"index:idx_favorites_user_service,unique"`
+	ServiceID uint `gorm:"index:idx_favorites_user_service,unique"`
+}
@@ -1,15 +1,35 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Feedback is scored for sentiment in the background after it is saved.
+// Until then it is pending; it is marked failed once scoring has been
+// retried too many times.
+const (
+	SentimentStatusPending = "pending"
+	SentimentStatusScored  = "scored"
+	SentimentStatusFailed  = "failed"
+)
 
 type Feedback struct {
 	gorm.Model
-	Description string
-	Rating      uint
-	Positive    float64
-	Negative    float64
-	FromUserID  uint
-	FromUser    User
-	ToUserID    uint
-	ToUser      User
+	Description       string
+	Rating            uint
+	Reply             string
+	RepliedAt         *time.Time
+	Positive          float64
+	Negative          float64
+	SentimentStatus   string
+	SentimentAttempts int
+	SentimentRetryAt  *time.Time
+	FromUserID        uint
+	FromUser          User
+	ToUserID          uint
+	ToUser            User
+	OrderID           *uint `gorm:"uniqueIndex:idx_feedbacks_order_id"`
+	Order             Order
 }
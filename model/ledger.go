@@ -0,0 +1,45 @@
+package model
+
+import "gorm.io/gorm"
+
+const (
+	LedgerAccountGateway   = "gateway"
+	LedgerAccountEscrow    = "escrow"
+	LedgerAccountOrganizer = "organizer"
+	LedgerAccountPayout    = "payout"
+	LedgerAccountDisbursed = "disbursed"
+	LedgerAccountRevenue   = "revenue"
+)
+
+const (
+	LedgerKindPayment       = "payment"
+	LedgerKindRelease       = "release"
+	LedgerKindPayout        = "payout"
+	LedgerKindPayoutPaid    = "payout_paid"
+	LedgerKindPayoutReverse = "payout_reverse"
+)
+
+// Entries of a transaction always sum to zero. The balance of an account is
+// the sum of its entries' amounts.
+type LedgerTransaction struct {
+	gorm.Model
+	Kind     string
+	OrderID  *uint
+	PayoutID *uint
+	Entries  []LedgerEntry
+}
+
+type LedgerEntry struct {
+	gorm.Model
+	LedgerTransactionID uint
+	LedgerTransaction   LedgerTransaction
+	Account             string
+	UserID              uint
+	Amount              Money
+}
+
+type Balance struct {
+	Available Money
+	Escrow    Money
+	Payout    Money
+}
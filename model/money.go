@@ -0,0 +1,16 @@
+package model
+
+import "math"
+
+// Currency is the ISO 4217 code every Money amount is denominated in.
+const Currency = "IDR"
+
+// Money is an amount in the minor unit of Currency. Rupiah has no minor unit
+// in circulation, so a Money of 1 is one rupiah.
+type Money int64
+
+// Mul scales the amount by a rate, such as a commission rate, rounding half
+// away from zero to the nearest minor unit.
+func (m Money) Mul(rate float64) Money {
+	return Money(math.Round(float64(m) * rate))
+}
@@ -8,16 +8,50 @@ import (
 
 type Order struct {
 	gorm.Model
-	IsAccepted  bool
-	IsCompleted bool
-	DateOfEvent time.Time
-	FirstName   string
-	LastName    string
-	Phone       string
-	Email       string
-	Address     string
-	Note        string
-	UserID      uint
-	User        User
-	Services    []Service `gorm:"many2many:order_services;"`
+	IsAccepted    bool
+	IsCompleted   bool
+	DateOfEvent   time.Time
+	FirstName     string
+	LastName      string
+	Phone         string
+	Email         string
+	Address       string
+	Note          string
+	PaymentMethod string
+	Bank          string
+	UserID        uint
+	User          User
+	Services      []Service `gorm:"many2many:order_services;"`
+	Items         []OrderItem
+}
+
+// Lines returns what the order charges for. Orders placed before line
+// items were recorded are charged one line per service at its cost.
+func (o Order) Lines() []OrderItem {
+	if len(o.Items) > 0 {
+		return o.Items
+	}
+
+	lines := make([]OrderItem, 0, len(o.Services))
+	for _, service := range o.Services {
+		lines = append(lines, OrderItem{
+			OrderID:   o.ID,
+			ServiceID: service.ID,
+			Kind:      OrderItemKindService,
+			Name:      service.Name,
+			Price:     service.Cost,
+			Quantity:  1,
+		})
+	}
+
+	return lines
+}
+
+func (o Order) TotalCost() Money {
+	var total Money
+	for _, line := range o.Lines() {
+		total += line.Total()
+	}
+
+	return total
 }
@@ -0,0 +1,31 @@
+package model
+
+import "gorm.io/gorm"
+
+const (
+	OrderItemKindService = "service"
+	OrderItemKindVariant = "variant"
+	OrderItemKindAddon   = "addon"
+)
+
+// OrderItem is a priced line of an order. Names and prices are copied from
+// the service when the order is placed, so later edits to the service do
+// not change what the customer agreed to pay.
+type OrderItem struct {
+	gorm.Model
+	OrderID   uint
+	ServiceID uint
+	// ServiceRevisionID is the revision of the service current when the
+	// order was placed. Orders placed before revisions were kept have none.
+	ServiceRevisionID *uint
+	VariantID         *uint
+	AddonID           *uint
+	Kind              string
+	Name              string
+	Price             Money
+	Quantity          int
+}
+
+func (i OrderItem) Total() Money {
+	return i.Price * Money(i.Quantity)
+}
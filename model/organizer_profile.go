@@ -0,0 +1,28 @@
+package model
+
+import "gorm.io/gorm"
+
+// OrganizerProfile is the public business profile of an organizer.
+type OrganizerProfile struct {
+	gorm.Model
+	UserID      uint `gorm:"index:,unique"`
+	DisplayName string
+	Bio         string
+	City        string
+	LogoURL     string
+	Phone       string
+	Email       string
+	Website     string
+	SocialLinks map[string]string `gorm:"serializer:json"`
+	FoundedYear int
+}
+
+// Organizer gathers what the public organizer page shows. It is assembled
+// from several tables and is not stored itself.
+type Organizer struct {
+	User            User
+	Profile         OrganizerProfile
+	Services        []Service
+	Rating          RatingAggregate
+	CompletedEvents int64
+}
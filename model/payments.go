@@ -4,8 +4,32 @@ import "gorm.io/gorm"
 
 type Payment struct {
 	gorm.Model
-	Amount  float64
-	Status  string
-	OrderID uint
-	Order   Order
+	Amount      Money
+	Commission  Money
+	Earning     Money
+	Status      string
+	Method      string
+	Bank        string
+	VANumber    string
+	BillKey     string
+	BillerCode  string
+	QRString    string
+	QRURL       string
+	Deeplink    string
+	ExpiryTime  string
+	SnapToken   string
+	RedirectURL string
+	ReceiptKey  string
+	ReceiptURL  string
+	ReceiptNote string
+	OrderID     uint
+	Order       Order
+}
+
+type Revenue struct {
+	UserID     uint
+	User       User
+	Gross      Money
+	Commission Money
+	Earning    Money
 }
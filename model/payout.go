@@ -0,0 +1,13 @@
+package model
+
+import "gorm.io/gorm"
+
+type Payout struct {
+	gorm.Model
+	Amount        Money
+	Status        string
+	UserID        uint
+	User          User
+	BankAccountID uint
+	BankAccount   BankAccount
+}
@@ -0,0 +1,72 @@
+package model
+
+import "gorm.io/gorm"
+
+const (
+	RatedTypeOrganizer = "organizer"
+	RatedTypeService   = "service"
+)
+
+// RatingAggregate keeps running totals of the feedback given to an
+// organizer or a service, so ratings can be shown without going through
+// the feedbacks table.
+type RatingAggregate struct {
+	gorm.Model
+	RatedType     string `gorm:"index:idx_rating_aggregates_rated,unique"`
+	RatedID       uint   `gorm:"index:idx_rating_aggregates_rated,unique"`
+	Count         int64
+	Total         int64
+	Star1         int64
+	Star2         int64
+	Star3         int64
+	Star4         int64
+	Star5         int64
+	PositiveCount int64
+	NegativeCount int64
+}
+
+// Add counts feedback in the aggregate.
+func (a *RatingAggregate) Add(feedback Feedback) {
+	a.Count++
+	a.Total += int64(feedback.Rating)
+
+	switch feedback.Rating {
+	case 1:
+		a.Star1++
+	case 2:
+		a.Star2++
+	case 3:
+		a.Star3++
+	case 4:
+		a.Star4++
+	case 5:
+		a.Star5++
+	}
+
+	if feedback.Positive > 0 {
+		a.PositiveCount++
+	}
+	if feedback.Negative > 0 {
+		a.NegativeCount++
+	}
+}
+
+func (a RatingAggregate) Average() float64 {
+	return a.share(a.Total)
+}
+
+func (a RatingAggregate) PositiveShare() float64 {
+	return a.share(a.PositiveCount)
+}
+
+func (a RatingAggregate) NegativeShare() float64 {
+	return a.share(a.NegativeCount)
+}
+
+func (a RatingAggregate) share(n int64) float64 {
+	if a.Count == 0 {
+		return 0
+	}
+
+	return float64(n) / float64(a.Count)
+}
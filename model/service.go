@@ -1,14 +1,99 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Service struct {
 	gorm.Model
-	UserID      uint
+	UserID      uint `gorm:"index:idx_services_user_external,unique"`
 	User        User
+	CategoryID  *uint
+	Category    Category
+	Tags        []Tag `gorm:"many2many:service_tags;"`
+	Images      []ServiceImage
+	Variants    []ServiceVariant
+	Addons      []ServiceAddon
+	Rating      RatingAggregate `gorm:"polymorphic:Rated;polymorphicValue:service"`
 	Name        string
-	Cost        float64
+	Cost        Money
 	Phone       string
 	Email       string
 	Description string
+	Status      string
+	Highlights  map[string]string `gorm:"-"`
+
+	// ExternalID is the organizer's own reference for the service, used to
+	// match rows when services are imported again.
+	ExternalID *string `gorm:"index:idx_services_user_external,unique"`
+
+	// FavoriteCount is only filled in for the organizer's own listing.
+	FavoriteCount *int64 `gorm:"-"`
+}
+
+// ServiceExportIDPrefix starts the external ID a service without one is
+// exported under, which is followed by the service's ID.
+const ServiceExportIDPrefix = "#"
+
+// ExportID is the external ID the service is exported under, so that
+// importing the export again updates it rather than creating a copy.
+func (s Service) ExportID() string {
+	if s.ExternalID != nil {
+		return *s.ExternalID
+	}
+
+	return fmt.Sprintf("%s%d", ServiceExportIDPrefix, s.ID)
+}
+
+// Only published services are listed and can be ordered. Archived services
+// are kept so that the orders placed for them still resolve.
+const (
+	ServiceStatusDraft     = "draft"
+	ServiceStatusPublished = "published"
+	ServiceStatusArchived  = "archived"
+)
+
+const (
+	ServiceSortRelevance = "relevance"
+	ServiceSortNewest    = "newest"
+	ServiceSortPriceAsc  = "price_asc"
+	ServiceSortPriceDesc = "price_desc"
+	ServiceSortRating    = "rating"
+	ServiceSortPopular   = "popular"
+)
+
+type ServiceFilter struct {
+	IDs         []uint
+	Status      string
+	Keyword     string
+	CategoryIDs []uint
+	Tag         string
+	MinPrice    Money
+	MaxPrice    Money
+	OrganizerID uint
+	MinRating   float64
+	AvailableOn *time.Time
+	Sort        string
+	Offset      int
+	Limit       int
+}
+
+// ServiceFacets summarizes every service matching a filter, ignoring its
+// sort and pagination, so clients can show how many results each refinement
+// would leave.
+type ServiceFacets struct {
+	Total      int64
+	Categories []FacetCount
+	Organizers []FacetCount
+	Prices     []FacetCount
+	Ratings    []FacetCount
+}
+
+type FacetCount struct {
+	Value string
+	Label string
+	Count int64
 }
@@ -0,0 +1,13 @@
+package model
+
+import "gorm.io/gorm"
+
+// ServiceAddon is an optional extra a customer can add to a service, such as
+// an extra hour. MaxQuantity caps how many can be ordered at once.
+type ServiceAddon struct {
+	gorm.Model
+	ServiceID   uint
+	Name        string
+	Price       Money
+	MaxQuantity int
+}
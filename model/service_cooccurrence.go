@@ -0,0 +1,21 @@
+package model
+
+import "gorm.io/gorm"
+
+// ServiceCooccurrence counts the events a customer booked both services
+// for, whether in one order or in orders with different organizers for the
+// same day. The table is recomputed from orders periodically rather than
+// kept up to date as orders are placed.
+type ServiceCooccurrence struct {
+	gorm.Model
+	ServiceID        uint `gorm:"index:idx_service_cooccurrences_pair,unique"`
+	RelatedServiceID uint `gorm:"index:idx_service_cooccurrences_pair,unique"`
+	Count            int64
+}
+
+// RelatedServices are the services shown next to a service so customers
+// assembling an event find more vendors.
+type RelatedServices struct {
+	BookedTogether []Service
+	Similar        []Service
+}
@@ -0,0 +1,15 @@
+package model
+
+import "gorm.io/gorm"
+
+type ServiceImage struct {
+	gorm.Model
+	ServiceID    uint
+	Key          string
+	URL          string
+	ThumbnailKey string
+	ThumbnailURL string
+	Caption      string
+	Position     int
+	IsCover      bool
+}
@@ -0,0 +1,36 @@
+package model
+
+const (
+	ServiceImportActionCreate = "create"
+	ServiceImportActionUpdate = "update"
+)
+
+// ServiceImport reports what importing a file of services did or, on a dry
+// run, would do. Nothing is saved unless every row is valid, so Created and
+// Updated stay zero otherwise.
+type ServiceImport struct {
+	DryRun  bool
+	Created int
+	Updated int
+	Rows    []ServiceImportRow
+}
+
+// ServiceImportRow is the outcome of one row, numbered from 1 without
+// counting the CSV header. Errors are keyed by field.
+type ServiceImportRow struct {
+	Row        int
+	ExternalID string
+	Action     string
+	ServiceID  uint
+	Errors     map[string]string
+}
+
+// Valid reports whether every row of the import passed validation.
+func (i ServiceImport) Valid() bool {
+	for _, row := range i.Rows {
+		if len(row.Errors) > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Only published questions are listed. Pending questions wait for an admin
+// because moderation held them; hidden ones were taken down.
+const (
+	QuestionStatusPending   = "pending"
+	QuestionStatusPublished = "published"
+	QuestionStatusHidden    = "hidden"
+)
+
+// ServiceQuestion is a question a prospective customer asked about a
+// service, with the organizer's answer once given.
+type ServiceQuestion struct {
+	gorm.Model
+	ServiceID  uint
+	UserID     uint
+	User       User
+	Question   string
+	Answer     string
+	AnsweredAt *time.Time
+	Status     string
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// ServiceRevision is a snapshot of a service as it was after one of its
+// edits. Number counts the revisions of each service from 1.
+type ServiceRevision struct {
+	gorm.Model
+	ServiceID   uint `gorm:"index:idx_service_revisions_service_number,unique"`
+	Number      int  `gorm:"index:idx_service_revisions_service_number,unique"`
+	UserID      uint
+	User        User
+	Name        string
+	Cost        Money
+	Phone       string
+	Email       string
+	Description string
+	Status      string
+	CategoryID  *uint
+	Tags        []string          `gorm:"serializer:json"`
+	Variants    []RevisionVariant `gorm:"serializer:json"`
+	Addons      []RevisionAddon   `gorm:"serializer:json"`
+}
+
+// RevisionVariant is a variant as a revision snapshots it, so that the price
+// an order was placed at is kept with the revision it links to.
+type RevisionVariant struct {
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	Price       Money    `json:"price"`
+	Description string   `json:"description"`
+	Items       []string `json:"items"`
+}
+
+// RevisionAddon is an add-on as a revision snapshots it.
+type RevisionAddon struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Price       Money  `json:"price"`
+	MaxQuantity int    `json:"max_quantity"`
+}
+
+// RevisionChange is a field whose value differs between two revisions.
+type RevisionChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// NewServiceRevision snapshots service as edited by the user userID.
+func NewServiceRevision(service Service, userID uint) ServiceRevision {
+	tags := make([]string, 0, len(service.Tags))
+	for _, tag := range service.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	variants := make([]RevisionVariant, 0, len(service.Variants))
+	for _, variant := range service.Variants {
+		variants = append(variants, RevisionVariant{
+			ID:          variant.ID,
+			Name:        variant.Name,
+			Price:       variant.Price,
+			Description: variant.Description,
+			Items:       variant.Items,
+		})
+	}
+
+	addons := make([]RevisionAddon, 0, len(service.Addons))
+	for _, addon := range service.Addons {
+		addons = append(addons, RevisionAddon{
+			ID:          addon.ID,
+			Name:        addon.Name,
+			Price:       addon.Price,
+			MaxQuantity: addon.MaxQuantity,
+		})
+	}
+
+	return ServiceRevision{
+		ServiceID:   service.ID,
+		UserID:      userID,
+		Name:        service.Name,
+		Cost:        service.Cost,
+		Phone:       service.Phone,
+		Email:       service.Email,
+		Description: service.Description,
+		Status:      service.Status,
+		CategoryID:  service.CategoryID,
+		Tags:        tags,
+		Variants:    variants,
+		Addons:      addons,
+	}
+}
+
+// Changes lists the fields changed since previous. Every field counts as
+// changed for the first revision, which has no previous one.
+func (r ServiceRevision) Changes(previous *ServiceRevision) []RevisionChange {
+	if previous == nil {
+		previous = &ServiceRevision{}
+	}
+
+	fields := []struct {
+		Name string
+		From string
+		To   string
+	}{
+		{"name", previous.Name, r.Name},
+		{"cost", fmt.Sprintf("%d", previous.Cost), fmt.Sprintf("%d", r.Cost)},
+		{"phone", previous.Phone, r.Phone},
+		{"email", previous.Email, r.Email},
+		{"description", previous.Description, r.Description},
+		{"status", previous.Status, r.Status},
+		{"category_id", formatID(previous.CategoryID), formatID(r.CategoryID)},
+		{"tags", strings.Join(previous.Tags, ","), strings.Join(r.Tags, ",")},
+		{"variants", formatList(previous.Variants), formatList(r.Variants)},
+		{"addons", formatList(previous.Addons), formatList(r.Addons)},
+	}
+
+	changes := make([]RevisionChange, 0)
+	for _, field := range fields {
+		if field.From != field.To {
+			changes = append(changes, RevisionChange{field.Name, field.From, field.To})
+		}
+	}
+
+	return changes
+}
+
+func formatID(id *uint) string {
+	if id == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *id)
+}
+
+// formatList formats the variants or add-ons of a revision as JSON, or as
+// nothing when there are none.
+func formatList[T RevisionVariant | RevisionAddon](list []T) string {
+	if len(list) == 0 {
+		return ""
+	}
+
+	b, _ := json.Marshal(list)
+
+	return string(b)
+}
@@ -0,0 +1,12 @@
+package model
+
+import "gorm.io/gorm"
+
+type ServiceVariant struct {
+	gorm.Model
+	ServiceID   uint
+	Name        string
+	Price       Money
+	Description string
+	Items       []string `gorm:"serializer:json"`
+}
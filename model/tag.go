@@ -0,0 +1,8 @@
+package model
+
+import "gorm.io/gorm"
+
+type Tag struct {
+	gorm.Model
+	Name string `gorm:"uniqueIndex"`
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	WebhookStatusReceived  = "received"
+	WebhookStatusProcessed = "processed"
+	WebhookStatusIgnored   = "ignored"
+	WebhookStatusFailed    = "failed"
+)
+
+type WebhookNotification struct {
+	gorm.Model
+	TransactionID     string `gorm:"uniqueIndex:idx_webhook_notifications_key"`
+	TransactionStatus string `gorm:"uniqueIndex:idx_webhook_notifications_key"`
+	OrderID           string
+	PaymentType       string
+	Status            string
+	Error             string
+	Attempts          int
+	ProcessedAt       *time.Time
+}
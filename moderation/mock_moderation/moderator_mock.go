@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./moderation/moderator.go
+
+// Package mock_moderation is a generated GoMock package.
+package mock_moderation
+
+import (
+	reflect "reflect"
+
+	moderation "github.com/andikabahari/eoplatform/moderation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockModerator is a mock of Moderator interface.
+type MockModerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockModeratorMockRecorder
+}
+
+// MockModeratorMockRecorder is the mock recorder for MockModerator.
+type MockModeratorMockRecorder struct {
+	mock *MockModerator
+}
+
+// NewMockModerator creates a new mock instance.
+func NewMockModerator(ctrl *gomock.Controller) *MockModerator {
+	mock := &MockModerator{ctrl: ctrl}
+	mock.recorder = &MockModeratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerator) EXPECT() *MockModeratorMockRecorder {
+	return m.recorder
+}
+
+// Moderate mocks base method.
+func (m *MockModerator) Moderate(text string) moderation.Verdict {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Moderate", text)
+	ret0, _ := ret[0].(moderation.Verdict)
+	return ret0
+}
+
+// Moderate indicates an expected call of Moderate.
+func (mr *MockModeratorMockRecorder) Moderate(text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Moderate", reflect.TypeOf((*MockModerator)(nil).Moderate), text)
+}
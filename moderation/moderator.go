@@ -0,0 +1,61 @@
+package moderation
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type Verdict string
+
+const (
+	// Approve lets the text be shown right away.
+	Approve Verdict = "approve"
+	// Hold keeps the text hidden until an admin reviews it.
+	Hold Verdict = "hold"
+	// Reject refuses the text outright.
+	Reject Verdict = "reject"
+)
+
+// Moderator decides whether text written by users can be shown publicly.
+type Moderator interface {
+	Moderate(text string) Verdict
+}
+
+// contactPattern matches email addresses, links and phone numbers, which
+// are held so that deals are not taken off the platform.
+var contactPattern = regexp.MustCompile(`(?i)[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}|https?://|www\.|\+?\d[\d\s-]{8,}\d`)
+
+type keywordModerator struct {
+	blockedWords map[string]bool
+}
+
+// NewKeywordModerator holds text containing one of blockedWords or contact
+// details and approves everything else.
+func NewKeywordModerator(blockedWords []string) Moderator {
+	m := keywordModerator{make(map[string]bool)}
+	for _, word := range blockedWords {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			m.blockedWords[word] = true
+		}
+	}
+
+	return &m
+}
+
+func (m *keywordModerator) Moderate(text string) Verdict {
+	if contactPattern.MatchString(text) {
+		return Hold
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if m.blockedWords[word] {
+			return Hold
+		}
+	}
+
+	return Approve
+}
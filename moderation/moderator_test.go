@@ -0,0 +1,38 @@
+package moderation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type keywordModeratorSuite struct {
+	suite.Suite
+	moderator Moderator
+}
+
+func (s *keywordModeratorSuite) SetupTest() {
+	s.moderator = NewKeywordModerator([]string{"Scam", " ", "bodoh"})
+}
+
+func TestKeywordModeratorSuite(t *testing.T) {
+	suite.Run(t, new(keywordModeratorSuite))
+}
+
+func (s *keywordModeratorSuite) TestModerate() {
+	testCases := []struct {
+		Text     string
+		Expected Verdict
+	}{
+		{"Is the sound system included for 200 guests?", Approve},
+		{"Apakah harga sudah termasuk dekorasi?", Approve},
+		{"This looks like a SCAM!", Hold},
+		{"Contact me at budi@example.com", Hold},
+		{"See https://example.com for my offer", Hold},
+		{"WA aja ke 0812-3456-7890", Hold},
+	}
+
+	for _, testCase := range testCases {
+		s.Equal(testCase.Expected, s.moderator.Moderate(testCase.Text), testCase.Text)
+	}
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+)
+
+type CategoryRepository interface {
+	Get(categories *[]model.Category)
+	Find(category *model.Category, id any)
+	FindBySlug(category *model.Category, slug string)
+	Save(category *model.Category) error
+	Delete(category *model.Category) error
+	WithTx(tx Tx) CategoryRepository
+}
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) CategoryRepository {
+	return &categoryRepository{db}
+}
+
+func (r *categoryRepository) Get(categories *[]model.Category) {
+	r.db.Debug().Order("name").Find(categories)
+}
+
+func (r *categoryRepository) Find(category *model.Category, id any) {
+	r.db.Debug().Where("id = ?", id).Find(category)
+}
+
+func (r *categoryRepository) FindBySlug(category *model.Category, slug string) {
+	r.db.Debug().Where("slug = ?", slug).Find(category)
+}
+
+func (r *categoryRepository) Save(category *model.Category) error {
+	return r.db.Debug().Save(category).Error
+}
+
+// Delete deletes the category for good, so that its slug can be used again.
+func (r *categoryRepository) Delete(category *model.Category) error {
+	return r.db.Debug().Unscoped().Delete(category).Error
+}
+
+func (r *categoryRepository) WithTx(tx Tx) CategoryRepository {
+	return &categoryRepository{tx.db}
+}
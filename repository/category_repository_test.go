@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type categoryRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository CategoryRepository
+}
+
+func (s *categoryRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewCategoryRepository(gorm)
+}
+
+func TestCategoryRepositorySuite(t *testing.T) {
+	suite.Run(t, new(categoryRepositorySuite))
+}
+
+func (s *categoryRepositorySuite) TestGet() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `categories` WHERE `categories`.`deleted_at` IS NULL ORDER BY name")
+	s.mock.ExpectQuery(query).WillReturnRows(rows)
+	s.repository.Get(&[]model.Category{})
+}
+
+func (s *categoryRepositorySuite) TestFind() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `categories` WHERE id = ?")
+	s.mock.ExpectQuery(query).WithArgs("1").WillReturnRows(rows)
+	s.repository.Find(&model.Category{}, "1")
+}
+
+func (s *categoryRepositorySuite) TestFindBySlug() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `categories` WHERE slug = ?")
+	s.mock.ExpectQuery(query).WithArgs("venue").WillReturnRows(rows)
+	s.repository.FindBySlug(&model.Category{}, "venue")
+}
+
+func (s *categoryRepositorySuite) TestSave() {
+	query := regexp.QuoteMeta("INSERT INTO `categories`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Save(&model.Category{})
+}
+
+func (s *categoryRepositorySuite) TestDelete() {
+	query := regexp.QuoteMeta("DELETE FROM `categories` WHERE `categories`.`id` = ?")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.NoError(s.repository.Delete(&model.Category{Model: gorm.Model{ID: 1}}))
+}
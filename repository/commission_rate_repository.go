@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+)
+
+type CommissionRateRepository interface {
+	Get(rates *[]model.CommissionRate)
+	Find(rate *model.CommissionRate, id string)
+	FindByScope(rate *model.CommissionRate, scope string, scopeID uint)
+	Save(rate *model.CommissionRate)
+	Delete(rate *model.CommissionRate)
+	DeleteByScope(scope string, scopeID uint) error
+	WithTx(tx Tx) CommissionRateRepository
+}
+
+type commissionRateRepository struct {
+	db *gorm.DB
+}
+
+func NewCommissionRateRepository(db *gorm.DB) CommissionRateRepository {
+	return &commissionRateRepository{db}
+}
+
+func (r *commissionRateRepository) Get(rates *[]model.CommissionRate) {
+	r.db.Debug().Find(rates)
+}
+
+func (r *commissionRateRepository) Find(rate *model.CommissionRate, id string) {
+	r.db.Debug().Where("id = ?", id).Find(rate)
+}
+
+func (r *commissionRateRepository) FindByScope(rate *model.CommissionRate, scope string, scopeID uint) {
+	r.db.Debug().Where("scope = ? AND scope_id = ?", scope, scopeID).Find(rate)
+}
+
+func (r *commissionRateRepository) Save(rate *model.CommissionRate) {
+	r.db.Debug().Save(rate)
+}
+
+func (r *commissionRateRepository) Delete(rate *model.CommissionRate) {
+	r.db.Debug().Unscoped().Delete(rate)
+}
+
+func (r *commissionRateRepository) DeleteByScope(scope string, scopeID uint) error {
+	return r.db.Debug().Unscoped().Where("scope = ? AND scope_id = ?", scope, scopeID).Delete(&model.CommissionRate{}).Error
+}
+
+func (r *commissionRateRepository) WithTx(tx Tx) CommissionRateRepository {
+	return &commissionRateRepository{tx.db}
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type commissionRateRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository CommissionRateRepository
+}
+
+func (s *commissionRateRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewCommissionRateRepository(gorm)
+}
+
+func TestCommissionRateRepositorySuite(t *testing.T) {
+	suite.Run(t, new(commissionRateRepositorySuite))
+}
+
+func (s *commissionRateRepositorySuite) TestGet() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `commission_rates`")
+	s.mock.ExpectQuery(query).WillReturnRows(rows)
+	s.repository.Get(&[]model.CommissionRate{})
+}
+
+func (s *commissionRateRepositorySuite) TestFind() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `commission_rates` WHERE id = ?")
+	s.mock.ExpectQuery(query).WithArgs("1").WillReturnRows(rows)
+	s.repository.Find(&model.CommissionRate{}, "1")
+}
+
+func (s *commissionRateRepositorySuite) TestFindByScope() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `commission_rates` WHERE scope = ? AND scope_id = ?")
+	s.mock.ExpectQuery(query).WithArgs(model.CommissionScopeOrganizer, 1).WillReturnRows(rows)
+	s.repository.FindByScope(&model.CommissionRate{}, model.CommissionScopeOrganizer, 1)
+}
+
+func (s *commissionRateRepositorySuite) TestSave() {
+	query := regexp.QuoteMeta("INSERT INTO `commission_rates`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Save(&model.CommissionRate{})
+}
+
+func (s *commissionRateRepositorySuite) TestDelete() {
+	query := regexp.QuoteMeta("DELETE FROM `commission_rates`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.repository.Delete(&model.CommissionRate{Model: gorm.Model{ID: 1}})
+}
+
+func (s *commissionRateRepositorySuite) TestDeleteByScope() {
+	query := regexp.QuoteMeta("DELETE FROM `commission_rates` WHERE scope = ? AND scope_id = ?")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(model.CommissionScopeCategory, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.NoError(s.repository.DeleteByScope(model.CommissionScopeCategory, 1))
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FavoriteRepository interface {
+	GetServiceIDs(userID uint) []uint
+	Create(favorite *model.Favorite)
+	Delete(userID, serviceID uint)
+	CountForServices(services *[]model.Service)
+}
+
+type favoriteRepository struct {
+	db *gorm.DB
+}
+
+func NewFavoriteRepository(db *gorm.DB) FavoriteRepository {
+	return &favoriteRepository{db}
+}
+
+// GetServiceIDs returns the services a user saved, most recent first.
+func (r *favoriteRepository) GetServiceIDs(userID uint) []uint {
+	serviceIDs := make([]uint, 0)
+	r.db.Debug().Model(&model.Favorite{}).Where("user_id = ?", userID).Order("id DESC").Pluck("service_id", &serviceIDs)
+
+	return serviceIDs
+}
+
+// Create saves favorite unless the user already saved the service.
+func (r *favoriteRepository) Create(favorite *model.Favorite) {
+	r.db.Debug().Clauses(clause.OnConflict{DoNothing: true}).Create(favorite)
+}
+
+func (r *favoriteRepository) Delete(userID, serviceID uint) {
+	r.db.Debug().Unscoped().Where("user_id = ? AND service_id = ?", userID, serviceID).Delete(&model.Favorite{})
+}
+
+// CountForServices fills in how many users saved each of services.
+func (r *favoriteRepository) CountForServices(services *[]model.Service) {
+	if len(*services) == 0 {
+		return
+	}
+
+	serviceIDs := make([]uint, 0)
+	for _, service := range *services {
+		serviceIDs = append(serviceIDs, service.ID)
+	}
+
+	counts := []struct {
+		ServiceID uint
+		Count     int64
+	}{}
+	r.db.Debug().Model(&model.Favorite{}).
+		Select("service_id, COUNT(1) AS count").
+		Where("service_id IN ?", serviceIDs).
+		Group("service_id").
+		Scan(&counts)
+
+	countByID := make(map[uint]int64)
+	for _, count := range counts {
+		countByID[count.ServiceID] = count.Count
+	}
+
+	for i := range *services {
+		count := countByID[(*services)[i].ID]
+		(*services)[i].FavoriteCount = &count
+	}
+}
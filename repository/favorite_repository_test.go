@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type favoriteRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository FavoriteRepository
+}
+
+func (s *favoriteRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewFavoriteRepository(gorm)
+}
+
+func TestFavoriteRepositorySuite(t *testing.T) {
+	suite.Run(t, new(favoriteRepositorySuite))
+}
+
+func (s *favoriteRepositorySuite) TestGetServiceIDs() {
+	query := regexp.QuoteMeta("SELECT `service_id` FROM `favorites` WHERE user_id = ? AND `favorites`.`deleted_at` IS NULL ORDER BY id DESC")
+	rows := sqlmock.NewRows([]string{"service_id"}).AddRow(3).AddRow(1)
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+	s.Equal([]uint{3, 1}, s.repository.GetServiceIDs(1))
+}
+
+func (s *favoriteRepositorySuite) TestCreate() {
+	query := regexp.QuoteMeta("INSERT INTO `favorites`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query + ".*ON DUPLICATE KEY UPDATE `id`=`id`").WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Create(&model.Favorite{UserID: 1, ServiceID: 1})
+}
+
+func (s *favoriteRepositorySuite) TestDelete() {
+	query := regexp.QuoteMeta("DELETE FROM `favorites` WHERE user_id = ? AND service_id = ?")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.repository.Delete(1, 2)
+}
+
+func (s *favoriteRepositorySuite) TestCountForServices() {
+	query := regexp.QuoteMeta("SELECT service_id, COUNT(1) AS count FROM `favorites` WHERE service_id IN (?,?) AND `favorites`.`deleted_at` IS NULL GROUP BY `service_id`")
+	rows := sqlmock.NewRows([]string{"service_id", "count"}).AddRow(1, 4)
+	s.mock.ExpectQuery(query).WithArgs(1, 2).WillReturnRows(rows)
+	services := []model.Service{{Model: gorm.Model{ID: 1}}, {Model: gorm.Model{ID: 2}}}
+	s.repository.CountForServices(&services)
+	s.Equal(int64(4), *services[0].FavoriteCount)
+	s.Equal(int64(0), *services[1].FavoriteCount)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
@@ -1,17 +1,20 @@
 package repository
 
 import (
-	"database/sql"
+	"time"
 
 	"github.com/andikabahari/eoplatform/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type FeedbackRepository interface {
 	Get(feedbacks *[]model.Feedback, toUserID string)
-	Create(feedback *model.Feedback)
-	GetFeedbacksCount(fromUserID, toUserID any) int
-	GetOrdersCount(fromUserID, toUserID any) int
+	Create(feedback *model.Feedback) (bool, error)
+	Find(feedback *model.Feedback, id string)
+	UpdateReply(feedback *model.Feedback)
+	GetPendingSentiment(feedbacks *[]model.Feedback, dueAt time.Time, limit int)
+	UpdateSentiment(feedback *model.Feedback) bool
 }
 
 type feedbackRepository struct {
@@ -24,44 +27,60 @@ func NewFeedbackRepository(db *gorm.DB) FeedbackRepository {
 
 func (r *feedbackRepository) Get(feedbacks *[]model.Feedback, toUserID string) {
 	if toUserID != "" {
-		r.db.Debug().Preload("FromUser").Preload("ToUser").Where("to_user_id = ?", toUserID).Find(feedbacks)
+		r.db.Debug().Preload("FromUser").Preload("ToUser").Preload("Order.Services").Where("to_user_id = ?", toUserID).Find(feedbacks)
 	} else {
-		r.db.Debug().Preload("FromUser").Preload("ToUser").Find(feedbacks)
+		r.db.Debug().Preload("FromUser").Preload("ToUser").Preload("Order.Services").Find(feedbacks)
 	}
 }
 
-func (r *feedbackRepository) Create(feedback *model.Feedback) {
-	r.db.Debug().Omit("FromUser").Omit("ToUser").Save(feedback)
-}
-
-func (r *feedbackRepository) GetFeedbacksCount(fromUserID, toUserID any) int {
-	feedbacksCount := 0
-
-	query := "SELECT COUNT(1) FROM feedbacks " +
-		"WHERE from_user_id=@FromUserID AND to_user_id=@ToUserID"
+// Create saves a new feedback. It reports false, without an error, when
+// the order already has a feedback.
+func (r *feedbackRepository) Create(feedback *model.Feedback) (bool, error) {
+	result := r.db.Debug().Clauses(clause.OnConflict{DoNothing: true}).
+		Omit("FromUser", "ToUser", "Order").
+		Create(feedback)
+	if result.Error != nil {
+		return false, result.Error
+	}
 
-	r.db.Debug().Raw(query,
-		sql.Named("FromUserID", fromUserID),
-		sql.Named("ToUserID", toUserID),
-	).Scan(&feedbacksCount)
+	return result.RowsAffected > 0, nil
+}
 
-	return feedbacksCount
+func (r *feedbackRepository) Find(feedback *model.Feedback, id string) {
+	r.db.Debug().Preload("FromUser").Preload("ToUser").Preload("Order.Services").Where("id = ?", id).Find(feedback)
 }
 
-func (r *feedbackRepository) GetOrdersCount(fromUserID, toUserID any) int {
-	ordersCount := 0
+func (r *feedbackRepository) UpdateReply(feedback *model.Feedback) {
+	r.db.Debug().Model(feedback).Omit(clause.Associations).Updates(map[string]any{
+		"reply":      feedback.Reply,
+		"replied_at": feedback.RepliedAt,
+	})
+}
 
-	query := "SELECT COUNT(1) FROM(" +
-		"SELECT DISTINCT o.id FROM orders o " +
-		"JOIN order_services os ON os.order_id=o.id " +
-		"JOIN services s ON s.id=os.service_id " +
-		"WHERE o.user_id=@FromUserID AND s.user_id=@ToUserID AND is_completed>0" +
-		") AS t"
+// GetPendingSentiment returns up to limit feedbacks waiting to be scored
+// whose next attempt is due by dueAt, longest waiting first.
+func (r *feedbackRepository) GetPendingSentiment(feedbacks *[]model.Feedback, dueAt time.Time, limit int) {
+	r.db.Debug().Preload("Order.Services").
+		Where("sentiment_status = ? AND sentiment_retry_at <= ?", model.SentimentStatusPending, dueAt).
+		Order("sentiment_retry_at").
+		Order("id").
+		Limit(limit).
+		Find(feedbacks)
+}
 
-	r.db.Debug().Raw(query,
-		sql.Named("FromUserID", fromUserID),
-		sql.Named("ToUserID", toUserID),
-	).Scan(&ordersCount)
+// UpdateSentiment saves the outcome of scoring feedback. It only updates
+// feedback that is still pending and reports whether it did, so a score is
+// counted once even when two workers pick the same feedback.
+func (r *feedbackRepository) UpdateSentiment(feedback *model.Feedback) bool {
+	result := r.db.Debug().Model(feedback).Omit(clause.Associations).
+		Where("sentiment_status = ?", model.SentimentStatusPending).
+		Updates(map[string]any{
+			"positive":           feedback.Positive,
+			"negative":           feedback.Negative,
+			"sentiment_status":   feedback.SentimentStatus,
+			"sentiment_attempts": feedback.SentimentAttempts,
+			"sentiment_retry_at": feedback.SentimentRetryAt,
+		})
 
-	return ordersCount
+	return result.RowsAffected > 0
 }
@@ -4,11 +4,13 @@ import (
 	"database/sql"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/andikabahari/eoplatform/model"
 	"github.com/andikabahari/eoplatform/testhelper"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 )
 
 type feedbackRepositorySuite struct {
@@ -42,21 +44,61 @@ func (s *feedbackRepositorySuite) TestGet() {
 func (s *feedbackRepositorySuite) TestCreate() {
 	query := regexp.QuoteMeta("INSERT INTO `feedbacks`")
 	s.mock.ExpectBegin()
-	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectExec(query + ".*" + regexp.QuoteMeta("ON DUPLICATE KEY UPDATE `id`=`id`")).WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
-	s.repository.Create(&model.Feedback{})
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	created, err := s.repository.Create(&model.Feedback{})
+	s.NoError(err)
+	s.True(created)
+
+	created, err = s.repository.Create(&model.Feedback{})
+	s.NoError(err)
+	s.False(created)
+	s.NoError(s.mock.ExpectationsWereMet())
 }
 
-func (s *feedbackRepositorySuite) TestGetFeedbacksCount() {
-	rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
-	query := regexp.QuoteMeta("SELECT COUNT(1) FROM feedbacks WHERE from_user_id=? AND to_user_id=?")
-	s.mock.ExpectQuery(query).WillReturnRows(rows)
-	s.repository.GetFeedbacksCount(1, 2)
+func (s *feedbackRepositorySuite) TestFind() {
+	rows := sqlmock.NewRows([]string{"id", "order_id"}).AddRow(1, nil)
+	query := regexp.QuoteMeta("SELECT * FROM `feedbacks` WHERE id = ? AND `feedbacks`.`deleted_at` IS NULL")
+	s.mock.ExpectQuery(query).WithArgs("1").WillReturnRows(rows)
+	feedback := model.Feedback{}
+	s.repository.Find(&feedback, "1")
+	s.Equal(uint(1), feedback.ID)
 }
 
-func (s *feedbackRepositorySuite) TestGetOrdersCount() {
-	rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
-	query := regexp.QuoteMeta("SELECT COUNT(1) FROM(SELECT DISTINCT o.id FROM orders o JOIN order_services os ON os.order_id=o.id JOIN services s ON s.id=os.service_id WHERE o.user_id=? AND s.user_id=? AND is_completed>0) AS t")
-	s.mock.ExpectQuery(query).WillReturnRows(rows)
-	s.repository.GetOrdersCount(1, 2)
+func (s *feedbackRepositorySuite) TestUpdateReply() {
+	repliedAt := time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC)
+	query := regexp.QuoteMeta("UPDATE `feedbacks` SET `replied_at`=?,`reply`=?,`updated_at`=? WHERE `feedbacks`.`deleted_at` IS NULL AND `id` = ?")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(repliedAt, "Thank you!", sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.repository.UpdateReply(&model.Feedback{Model: gorm.Model{ID: 1}, Reply: "Thank you!", RepliedAt: &repliedAt})
+}
+
+func (s *feedbackRepositorySuite) TestGetPendingSentiment() {
+	dueAt := time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "order_id"}).AddRow(1, nil)
+	query := regexp.QuoteMeta("SELECT * FROM `feedbacks` WHERE (sentiment_status = ? AND sentiment_retry_at <= ?) AND `feedbacks`.`deleted_at` IS NULL ORDER BY sentiment_retry_at,id LIMIT 20")
+	s.mock.ExpectQuery(query).WithArgs("pending", dueAt).WillReturnRows(rows)
+	feedbacks := make([]model.Feedback, 0)
+	s.repository.GetPendingSentiment(&feedbacks, dueAt, 20)
+	s.Len(feedbacks, 1)
+}
+
+func (s *feedbackRepositorySuite) TestUpdateSentiment() {
+	query := regexp.QuoteMeta("UPDATE `feedbacks` SET `negative`=?,`positive`=?,`sentiment_attempts`=?,`sentiment_retry_at`=?,`sentiment_status`=?,`updated_at`=? WHERE sentiment_status = ? AND `feedbacks`.`deleted_at` IS NULL AND `id` = ?")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(0.0, 0.8, 1, nil, "scored", sqlmock.AnyArg(), "pending", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	feedback := model.Feedback{Model: gorm.Model{ID: 1}, Positive: 0.8, SentimentStatus: "scored", SentimentAttempts: 1}
+	s.True(s.repository.UpdateSentiment(&feedback))
+	s.False(s.repository.UpdateSentiment(&feedback))
+	s.NoError(s.mock.ExpectationsWereMet())
 }
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LedgerRepository interface {
+	Post(transaction *model.LedgerTransaction) error
+	LockAccount(userID uint) error
+	HasTransaction(kind string, orderID uint) (bool, error)
+	GetBalance(account string, userID uint) model.Money
+	GetEntries(entries *[]model.LedgerEntry, account string, userID uint)
+	WithTx(tx Tx) LedgerRepository
+}
+
+type ledgerRepository struct {
+	db *gorm.DB
+}
+
+func NewLedgerRepository(db *gorm.DB) LedgerRepository {
+	return &ledgerRepository{db}
+}
+
+func (r *ledgerRepository) Post(transaction *model.LedgerTransaction) error {
+	return r.db.Debug().Save(transaction).Error
+}
+
+// LockAccount locks the user's row until the transaction the repository is
+// bound to ends. Whatever checks a balance before debiting it takes this
+// lock first, so two debits can't both spend the same balance.
+func (r *ledgerRepository) LockAccount(userID uint) error {
+	return r.db.Debug().Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", userID).
+		Take(&model.User{}).Error
+}
+
+// HasTransaction reports whether a transaction of kind was posted for the
+// order.
+func (r *ledgerRepository) HasTransaction(kind string, orderID uint) (bool, error) {
+	var count int64
+	err := r.db.Debug().Model(&model.LedgerTransaction{}).
+		Where("kind = ? AND order_id = ?", kind, orderID).
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *ledgerRepository) GetBalance(account string, userID uint) model.Money {
+	var balance model.Money
+
+	query := "SELECT COALESCE(SUM(amount), 0) FROM ledger_entries " +
+		"WHERE account=@Account AND user_id=@UserID AND deleted_at IS NULL"
+
+	r.db.Debug().Raw(query,
+		sql.Named("Account", account),
+		sql.Named("UserID", userID),
+	).Scan(&balance)
+
+	return balance
+}
+
+func (r *ledgerRepository) GetEntries(entries *[]model.LedgerEntry, account string, userID uint) {
+	r.db.Debug().Preload("LedgerTransaction").
+		Where("account = ? AND user_id = ?", account, userID).
+		Order("id DESC").
+		Find(entries)
+}
+
+func (r *ledgerRepository) WithTx(tx Tx) LedgerRepository {
+	return &ledgerRepository{tx.db}
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+)
+
+type ledgerRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository LedgerRepository
+}
+
+func (s *ledgerRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewLedgerRepository(gorm)
+}
+
+func TestLedgerRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ledgerRepositorySuite))
+}
+
+func (s *ledgerRepositorySuite) TestPost() {
+	var query string
+	s.mock.ExpectBegin()
+	query = regexp.QuoteMeta("INSERT INTO `ledger_transactions`")
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	query = regexp.QuoteMeta("INSERT INTO `ledger_entries`")
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 2))
+	s.mock.ExpectCommit()
+	s.repository.Post(&model.LedgerTransaction{
+		Kind: model.LedgerKindPayment,
+		Entries: []model.LedgerEntry{
+			{Account: model.LedgerAccountGateway, UserID: 1, Amount: -1000},
+			{Account: model.LedgerAccountEscrow, UserID: 1, Amount: 1000},
+		},
+	})
+}
+
+func (s *ledgerRepositorySuite) TestLockAccount() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT `id` FROM `users` WHERE id = ? AND `users`.`deleted_at` IS NULL LIMIT 1 FOR UPDATE")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+	s.NoError(s.repository.LockAccount(1))
+}
+
+func (s *ledgerRepositorySuite) TestHasTransaction() {
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT count(*) FROM `ledger_transactions` WHERE (kind = ? AND order_id = ?) AND `ledger_transactions`.`deleted_at` IS NULL")
+	s.mock.ExpectQuery(query).WithArgs(model.LedgerKindRelease, 1).WillReturnRows(rows)
+
+	exists, err := s.repository.HasTransaction(model.LedgerKindRelease, 1)
+	s.NoError(err)
+	s.True(exists)
+}
+
+func (s *ledgerRepositorySuite) TestGetBalance() {
+	rows := sqlmock.NewRows([]string{"balance"}).AddRow(1000)
+	query := regexp.QuoteMeta("SELECT COALESCE(SUM(amount), 0) FROM ledger_entries WHERE account=? AND user_id=? AND deleted_at IS NULL")
+	s.mock.ExpectQuery(query).WithArgs(model.LedgerAccountOrganizer, 1).WillReturnRows(rows)
+	s.Equal(model.Money(1000), s.repository.GetBalance(model.LedgerAccountOrganizer, 1))
+}
+
+func (s *ledgerRepositorySuite) TestGetEntries() {
+	var query string
+	rows := sqlmock.NewRows([]string{"id", "ledger_transaction_id"}).AddRow(1, 1)
+	query = regexp.QuoteMeta("SELECT * FROM `ledger_entries`")
+	s.mock.ExpectQuery(query).WillReturnRows(rows)
+	query = regexp.QuoteMeta("SELECT * FROM `ledger_transactions`")
+	s.mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	s.repository.GetEntries(&[]model.LedgerEntry{}, model.LedgerAccountOrganizer, 1)
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/category_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	repository "github.com/andikabahari/eoplatform/repository"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCategoryRepository is a mock of CategoryRepository interface.
+type MockCategoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryRepositoryMockRecorder
+}
+
+// MockCategoryRepositoryMockRecorder is the mock recorder for MockCategoryRepository.
+type MockCategoryRepositoryMockRecorder struct {
+	mock *MockCategoryRepository
+}
+
+// NewMockCategoryRepository creates a new mock instance.
+func NewMockCategoryRepository(ctrl *gomock.Controller) *MockCategoryRepository {
+	mock := &MockCategoryRepository{ctrl: ctrl}
+	mock.recorder = &MockCategoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryRepository) EXPECT() *MockCategoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCategoryRepository) Delete(category *model.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCategoryRepositoryMockRecorder) Delete(category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryRepository)(nil).Delete), category)
+}
+
+// Find mocks base method.
+func (m *MockCategoryRepository) Find(category *model.Category, id any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Find", category, id)
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockCategoryRepositoryMockRecorder) Find(category, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockCategoryRepository)(nil).Find), category, id)
+}
+
+// FindBySlug mocks base method.
+func (m *MockCategoryRepository) FindBySlug(category *model.Category, slug string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FindBySlug", category, slug)
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockCategoryRepositoryMockRecorder) FindBySlug(category, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockCategoryRepository)(nil).FindBySlug), category, slug)
+}
+
+// Get mocks base method.
+func (m *MockCategoryRepository) Get(categories *[]model.Category) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", categories)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCategoryRepositoryMockRecorder) Get(categories interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCategoryRepository)(nil).Get), categories)
+}
+
+// Save mocks base method.
+func (m *MockCategoryRepository) Save(category *model.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockCategoryRepositoryMockRecorder) Save(category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockCategoryRepository)(nil).Save), category)
+}
+
+// WithTx mocks base method.
+func (m *MockCategoryRepository) WithTx(tx repository.Tx) repository.CategoryRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.CategoryRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockCategoryRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockCategoryRepository)(nil).WithTx), tx)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/commission_rate_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	repository "github.com/andikabahari/eoplatform/repository"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCommissionRateRepository is a mock of CommissionRateRepository interface.
+type MockCommissionRateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommissionRateRepositoryMockRecorder
+}
+
+// MockCommissionRateRepositoryMockRecorder is the mock recorder for MockCommissionRateRepository.
+type MockCommissionRateRepositoryMockRecorder struct {
+	mock *MockCommissionRateRepository
+}
+
+// NewMockCommissionRateRepository creates a new mock instance.
+func NewMockCommissionRateRepository(ctrl *gomock.Controller) *MockCommissionRateRepository {
+	mock := &MockCommissionRateRepository{ctrl: ctrl}
+	mock.recorder = &MockCommissionRateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommissionRateRepository) EXPECT() *MockCommissionRateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCommissionRateRepository) Delete(rate *model.CommissionRate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", rate)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCommissionRateRepositoryMockRecorder) Delete(rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommissionRateRepository)(nil).Delete), rate)
+}
+
+// DeleteByScope mocks base method.
+func (m *MockCommissionRateRepository) DeleteByScope(scope string, scopeID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByScope", scope, scopeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByScope indicates an expected call of DeleteByScope.
+func (mr *MockCommissionRateRepositoryMockRecorder) DeleteByScope(scope, scopeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByScope", reflect.TypeOf((*MockCommissionRateRepository)(nil).DeleteByScope), scope, scopeID)
+}
+
+// Find mocks base method.
+func (m *MockCommissionRateRepository) Find(rate *model.CommissionRate, id string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Find", rate, id)
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockCommissionRateRepositoryMockRecorder) Find(rate, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockCommissionRateRepository)(nil).Find), rate, id)
+}
+
+// FindByScope mocks base method.
+func (m *MockCommissionRateRepository) FindByScope(rate *model.CommissionRate, scope string, scopeID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FindByScope", rate, scope, scopeID)
+}
+
+// FindByScope indicates an expected call of FindByScope.
+func (mr *MockCommissionRateRepositoryMockRecorder) FindByScope(rate, scope, scopeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByScope", reflect.TypeOf((*MockCommissionRateRepository)(nil).FindByScope), rate, scope, scopeID)
+}
+
+// Get mocks base method.
+func (m *MockCommissionRateRepository) Get(rates *[]model.CommissionRate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", rates)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCommissionRateRepositoryMockRecorder) Get(rates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCommissionRateRepository)(nil).Get), rates)
+}
+
+// Save mocks base method.
+func (m *MockCommissionRateRepository) Save(rate *model.CommissionRate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Save", rate)
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockCommissionRateRepositoryMockRecorder) Save(rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockCommissionRateRepository)(nil).Save), rate)
+}
+
+// WithTx mocks base method.
+func (m *MockCommissionRateRepository) WithTx(tx repository.Tx) repository.CommissionRateRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.CommissionRateRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockCommissionRateRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockCommissionRateRepository)(nil).WithTx), tx)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/favorite_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFavoriteRepository is a mock of FavoriteRepository interface.
+type MockFavoriteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFavoriteRepositoryMockRecorder
+}
+
+// MockFavoriteRepositoryMockRecorder is the mock recorder for MockFavoriteRepository.
+type MockFavoriteRepositoryMockRecorder struct {
+	mock *MockFavoriteRepository
+}
+
+// NewMockFavoriteRepository creates a new mock instance.
+func NewMockFavoriteRepository(ctrl *gomock.Controller) *MockFavoriteRepository {
+	mock := &MockFavoriteRepository{ctrl: ctrl}
+	mock.recorder = &MockFavoriteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFavoriteRepository) EXPECT() *MockFavoriteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountForServices mocks base method.
+func (m *MockFavoriteRepository) CountForServices(services *[]model.Service) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CountForServices", services)
+}
+
+// CountForServices indicates an expected call of CountForServices.
+func (mr *MockFavoriteRepositoryMockRecorder) CountForServices(services interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountForServices", reflect.TypeOf((*MockFavoriteRepository)(nil).CountForServices), services)
+}
+
+// Create mocks base method.
+func (m *MockFavoriteRepository) Create(favorite *model.Favorite) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Create", favorite)
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFavoriteRepositoryMockRecorder) Create(favorite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFavoriteRepository)(nil).Create), favorite)
+}
+
+// Delete mocks base method.
+func (m *MockFavoriteRepository) Delete(userID, serviceID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", userID, serviceID)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFavoriteRepositoryMockRecorder) Delete(userID, serviceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFavoriteRepository)(nil).Delete), userID, serviceID)
+}
+
+// GetServiceIDs mocks base method.
+func (m *MockFavoriteRepository) GetServiceIDs(userID uint) []uint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceIDs", userID)
+	ret0, _ := ret[0].([]uint)
+	return ret0
+}
+
+// GetServiceIDs indicates an expected call of GetServiceIDs.
+func (mr *MockFavoriteRepositoryMockRecorder) GetServiceIDs(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceIDs", reflect.TypeOf((*MockFavoriteRepository)(nil).GetServiceIDs), userID)
+}
@@ -6,6 +6,7 @@ package mock_repository
 
 import (
 	reflect "reflect"
+	time "time"
 
 	model "github.com/andikabahari/eoplatform/model"
 	gomock "github.com/golang/mock/gomock"
@@ -35,9 +36,12 @@ func (m *MockFeedbackRepository) EXPECT() *MockFeedbackRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockFeedbackRepository) Create(feedback *model.Feedback) {
+func (m *MockFeedbackRepository) Create(feedback *model.Feedback) (bool, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Create", feedback)
+	ret := m.ctrl.Call(m, "Create", feedback)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
@@ -46,6 +50,18 @@ func (mr *MockFeedbackRepositoryMockRecorder) Create(feedback interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFeedbackRepository)(nil).Create), feedback)
 }
 
+// Find mocks base method.
+func (m *MockFeedbackRepository) Find(feedback *model.Feedback, id string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Find", feedback, id)
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockFeedbackRepositoryMockRecorder) Find(feedback, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockFeedbackRepository)(nil).Find), feedback, id)
+}
+
 // Get mocks base method.
 func (m *MockFeedbackRepository) Get(feedbacks *[]model.Feedback, toUserID string) {
 	m.ctrl.T.Helper()
@@ -58,30 +74,40 @@ func (mr *MockFeedbackRepositoryMockRecorder) Get(feedbacks, toUserID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFeedbackRepository)(nil).Get), feedbacks, toUserID)
 }
 
-// GetFeedbacksCount mocks base method.
-func (m *MockFeedbackRepository) GetFeedbacksCount(fromUserID, toUserID any) int {
+// GetPendingSentiment mocks base method.
+func (m *MockFeedbackRepository) GetPendingSentiment(feedbacks *[]model.Feedback, dueAt time.Time, limit int) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFeedbacksCount", fromUserID, toUserID)
-	ret0, _ := ret[0].(int)
-	return ret0
+	m.ctrl.Call(m, "GetPendingSentiment", feedbacks, dueAt, limit)
+}
+
+// GetPendingSentiment indicates an expected call of GetPendingSentiment.
+func (mr *MockFeedbackRepositoryMockRecorder) GetPendingSentiment(feedbacks, dueAt, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingSentiment", reflect.TypeOf((*MockFeedbackRepository)(nil).GetPendingSentiment), feedbacks, dueAt, limit)
+}
+
+// UpdateReply mocks base method.
+func (m *MockFeedbackRepository) UpdateReply(feedback *model.Feedback) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateReply", feedback)
 }
 
-// GetFeedbacksCount indicates an expected call of GetFeedbacksCount.
-func (mr *MockFeedbackRepositoryMockRecorder) GetFeedbacksCount(fromUserID, toUserID interface{}) *gomock.Call {
+// UpdateReply indicates an expected call of UpdateReply.
+func (mr *MockFeedbackRepositoryMockRecorder) UpdateReply(feedback interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedbacksCount", reflect.TypeOf((*MockFeedbackRepository)(nil).GetFeedbacksCount), fromUserID, toUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReply", reflect.TypeOf((*MockFeedbackRepository)(nil).UpdateReply), feedback)
 }
 
-// GetOrdersCount mocks base method.
-func (m *MockFeedbackRepository) GetOrdersCount(fromUserID, toUserID any) int {
+// UpdateSentiment mocks base method.
+func (m *MockFeedbackRepository) UpdateSentiment(feedback *model.Feedback) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrdersCount", fromUserID, toUserID)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "UpdateSentiment", feedback)
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// GetOrdersCount indicates an expected call of GetOrdersCount.
-func (mr *MockFeedbackRepositoryMockRecorder) GetOrdersCount(fromUserID, toUserID interface{}) *gomock.Call {
+// UpdateSentiment indicates an expected call of UpdateSentiment.
+func (mr *MockFeedbackRepositoryMockRecorder) UpdateSentiment(feedback interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersCount", reflect.TypeOf((*MockFeedbackRepository)(nil).GetOrdersCount), fromUserID, toUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSentiment", reflect.TypeOf((*MockFeedbackRepository)(nil).UpdateSentiment), feedback)
 }
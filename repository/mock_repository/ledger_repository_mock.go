@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/ledger_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	repository "github.com/andikabahari/eoplatform/repository"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockLedgerRepository) GetBalance(account string, userID uint) model.Money {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", account, userID)
+	ret0, _ := ret[0].(model.Money)
+	return ret0
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockLedgerRepositoryMockRecorder) GetBalance(account, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockLedgerRepository)(nil).GetBalance), account, userID)
+}
+
+// GetEntries mocks base method.
+func (m *MockLedgerRepository) GetEntries(entries *[]model.LedgerEntry, account string, userID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetEntries", entries, account, userID)
+}
+
+// GetEntries indicates an expected call of GetEntries.
+func (mr *MockLedgerRepositoryMockRecorder) GetEntries(entries, account, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntries", reflect.TypeOf((*MockLedgerRepository)(nil).GetEntries), entries, account, userID)
+}
+
+// HasTransaction mocks base method.
+func (m *MockLedgerRepository) HasTransaction(kind string, orderID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasTransaction", kind, orderID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasTransaction indicates an expected call of HasTransaction.
+func (mr *MockLedgerRepositoryMockRecorder) HasTransaction(kind, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasTransaction", reflect.TypeOf((*MockLedgerRepository)(nil).HasTransaction), kind, orderID)
+}
+
+// LockAccount mocks base method.
+func (m *MockLedgerRepository) LockAccount(userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAccount", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAccount indicates an expected call of LockAccount.
+func (mr *MockLedgerRepositoryMockRecorder) LockAccount(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccount", reflect.TypeOf((*MockLedgerRepository)(nil).LockAccount), userID)
+}
+
+// Post mocks base method.
+func (m *MockLedgerRepository) Post(transaction *model.LedgerTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockLedgerRepositoryMockRecorder) Post(transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockLedgerRepository)(nil).Post), transaction)
+}
+
+// WithTx mocks base method.
+func (m *MockLedgerRepository) WithTx(tx repository.Tx) repository.LedgerRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.LedgerRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockLedgerRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockLedgerRepository)(nil).WithTx), tx)
+}
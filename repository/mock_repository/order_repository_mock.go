@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	model "github.com/andikabahari/eoplatform/model"
+	repository "github.com/andikabahari/eoplatform/repository"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -47,9 +48,11 @@ func (mr *MockOrderRepositoryMockRecorder) Create(order interface{}) *gomock.Cal
 }
 
 // Delete mocks base method.
-func (m *MockOrderRepository) Delete(order *model.Order) {
+func (m *MockOrderRepository) Delete(order *model.Order) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Delete", order)
+	ret := m.ctrl.Call(m, "Delete", order)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Delete indicates an expected call of Delete.
@@ -58,6 +61,21 @@ func (mr *MockOrderRepositoryMockRecorder) Delete(order interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrderRepository)(nil).Delete), order)
 }
 
+// DeleteUnaccepted mocks base method.
+func (m *MockOrderRepository) DeleteUnaccepted(order *model.Order) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnaccepted", order)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUnaccepted indicates an expected call of DeleteUnaccepted.
+func (mr *MockOrderRepositoryMockRecorder) DeleteUnaccepted(order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnaccepted", reflect.TypeOf((*MockOrderRepository)(nil).DeleteUnaccepted), order)
+}
+
 // Find mocks base method.
 func (m *MockOrderRepository) Find(order *model.Order, id string) {
 	m.ctrl.T.Helper()
@@ -82,6 +100,20 @@ func (mr *MockOrderRepositoryMockRecorder) FindOnly(order, id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnly", reflect.TypeOf((*MockOrderRepository)(nil).FindOnly), order, id)
 }
 
+// GetCompletedCountForOrganizer mocks base method.
+func (m *MockOrderRepository) GetCompletedCountForOrganizer(userID uint) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletedCountForOrganizer", userID)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetCompletedCountForOrganizer indicates an expected call of GetCompletedCountForOrganizer.
+func (mr *MockOrderRepositoryMockRecorder) GetCompletedCountForOrganizer(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletedCountForOrganizer", reflect.TypeOf((*MockOrderRepository)(nil).GetCompletedCountForOrganizer), userID)
+}
+
 // GetOrdersForCustomer mocks base method.
 func (m *MockOrderRepository) GetOrdersForCustomer(orders *[]model.Order, userID uint) {
 	m.ctrl.T.Helper()
@@ -107,9 +139,11 @@ func (mr *MockOrderRepositoryMockRecorder) GetOrdersForOrganizer(orders, userID
 }
 
 // Save mocks base method.
-func (m *MockOrderRepository) Save(order *model.Order) {
+func (m *MockOrderRepository) Save(order *model.Order) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Save", order)
+	ret := m.ctrl.Call(m, "Save", order)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Save indicates an expected call of Save.
@@ -117,3 +151,17 @@ func (mr *MockOrderRepositoryMockRecorder) Save(order interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrderRepository)(nil).Save), order)
 }
+
+// WithTx mocks base method.
+func (m *MockOrderRepository) WithTx(tx repository.Tx) repository.OrderRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.OrderRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockOrderRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockOrderRepository)(nil).WithTx), tx)
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/organizer_profile_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrganizerProfileRepository is a mock of OrganizerProfileRepository interface.
+type MockOrganizerProfileRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizerProfileRepositoryMockRecorder
+}
+
+// MockOrganizerProfileRepositoryMockRecorder is the mock recorder for MockOrganizerProfileRepository.
+type MockOrganizerProfileRepositoryMockRecorder struct {
+	mock *MockOrganizerProfileRepository
+}
+
+// NewMockOrganizerProfileRepository creates a new mock instance.
+func NewMockOrganizerProfileRepository(ctrl *gomock.Controller) *MockOrganizerProfileRepository {
+	mock := &MockOrganizerProfileRepository{ctrl: ctrl}
+	mock.recorder = &MockOrganizerProfileRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizerProfileRepository) EXPECT() *MockOrganizerProfileRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserID mocks base method.
+func (m *MockOrganizerProfileRepository) FindByUserID(profile *model.OrganizerProfile, userID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FindByUserID", profile, userID)
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockOrganizerProfileRepositoryMockRecorder) FindByUserID(profile, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockOrganizerProfileRepository)(nil).FindByUserID), profile, userID)
+}
+
+// Save mocks base method.
+func (m *MockOrganizerProfileRepository) Save(profile *model.OrganizerProfile) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Save", profile)
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockOrganizerProfileRepositoryMockRecorder) Save(profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrganizerProfileRepository)(nil).Save), profile)
+}
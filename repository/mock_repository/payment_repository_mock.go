@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	model "github.com/andikabahari/eoplatform/model"
+	repository "github.com/andikabahari/eoplatform/repository"
 	request "github.com/andikabahari/eoplatform/request"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -59,6 +60,18 @@ func (mr *MockPaymentRepositoryMockRecorder) FindOnlyByOrderID(payment, orderID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnlyByOrderID", reflect.TypeOf((*MockPaymentRepository)(nil).FindOnlyByOrderID), payment, orderID)
 }
 
+// GetEarnings mocks base method.
+func (m *MockPaymentRepository) GetEarnings(payments *[]model.Payment, userID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetEarnings", payments, userID)
+}
+
+// GetEarnings indicates an expected call of GetEarnings.
+func (mr *MockPaymentRepositoryMockRecorder) GetEarnings(payments, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEarnings", reflect.TypeOf((*MockPaymentRepository)(nil).GetEarnings), payments, userID)
+}
+
 // GetOnlyByOrderID mocks base method.
 func (m *MockPaymentRepository) GetOnlyByOrderID(payments *[]model.Payment, orderID any) {
 	m.ctrl.T.Helper()
@@ -71,10 +84,52 @@ func (mr *MockPaymentRepositoryMockRecorder) GetOnlyByOrderID(payments, orderID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnlyByOrderID", reflect.TypeOf((*MockPaymentRepository)(nil).GetOnlyByOrderID), payments, orderID)
 }
 
+// GetRevenue mocks base method.
+func (m *MockPaymentRepository) GetRevenue(revenues *[]model.Revenue, from, to string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetRevenue", revenues, from, to)
+}
+
+// GetRevenue indicates an expected call of GetRevenue.
+func (mr *MockPaymentRepositoryMockRecorder) GetRevenue(revenues, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevenue", reflect.TypeOf((*MockPaymentRepository)(nil).GetRevenue), revenues, from, to)
+}
+
+// LockByOrderID mocks base method.
+func (m *MockPaymentRepository) LockByOrderID(payment *model.Payment, orderID any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByOrderID", payment, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockByOrderID indicates an expected call of LockByOrderID.
+func (mr *MockPaymentRepositoryMockRecorder) LockByOrderID(payment, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByOrderID", reflect.TypeOf((*MockPaymentRepository)(nil).LockByOrderID), payment, orderID)
+}
+
+// Save mocks base method.
+func (m *MockPaymentRepository) Save(payment *model.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPaymentRepositoryMockRecorder) Save(payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPaymentRepository)(nil).Save), payment)
+}
+
 // Update mocks base method.
-func (m *MockPaymentRepository) Update(payment *model.Payment, req *request.MidtransTransactionNotificationRequest) {
+func (m *MockPaymentRepository) Update(payment *model.Payment, req *request.MidtransTransactionNotificationRequest) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Update", payment, req)
+	ret := m.ctrl.Call(m, "Update", payment, req)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Update indicates an expected call of Update.
@@ -82,3 +137,17 @@ func (mr *MockPaymentRepositoryMockRecorder) Update(payment, req interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPaymentRepository)(nil).Update), payment, req)
 }
+
+// WithTx mocks base method.
+func (m *MockPaymentRepository) WithTx(tx repository.Tx) repository.PaymentRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.PaymentRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockPaymentRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockPaymentRepository)(nil).WithTx), tx)
+}
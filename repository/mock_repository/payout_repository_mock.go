@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/payout_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	repository "github.com/andikabahari/eoplatform/repository"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPayoutRepository is a mock of PayoutRepository interface.
+type MockPayoutRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayoutRepositoryMockRecorder
+}
+
+// MockPayoutRepositoryMockRecorder is the mock recorder for MockPayoutRepository.
+type MockPayoutRepositoryMockRecorder struct {
+	mock *MockPayoutRepository
+}
+
+// NewMockPayoutRepository creates a new mock instance.
+func NewMockPayoutRepository(ctrl *gomock.Controller) *MockPayoutRepository {
+	mock := &MockPayoutRepository{ctrl: ctrl}
+	mock.recorder = &MockPayoutRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayoutRepository) EXPECT() *MockPayoutRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPayoutRepository) Create(payout *model.Payout) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", payout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPayoutRepositoryMockRecorder) Create(payout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPayoutRepository)(nil).Create), payout)
+}
+
+// Find mocks base method.
+func (m *MockPayoutRepository) Find(payout *model.Payout, id string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Find", payout, id)
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockPayoutRepositoryMockRecorder) Find(payout, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockPayoutRepository)(nil).Find), payout, id)
+}
+
+// Get mocks base method.
+func (m *MockPayoutRepository) Get(payouts *[]model.Payout, status string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", payouts, status)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPayoutRepositoryMockRecorder) Get(payouts, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPayoutRepository)(nil).Get), payouts, status)
+}
+
+// GetForUser mocks base method.
+func (m *MockPayoutRepository) GetForUser(payouts *[]model.Payout, userID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetForUser", payouts, userID)
+}
+
+// GetForUser indicates an expected call of GetForUser.
+func (mr *MockPayoutRepositoryMockRecorder) GetForUser(payouts, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUser", reflect.TypeOf((*MockPayoutRepository)(nil).GetForUser), payouts, userID)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockPayoutRepository) UpdateStatus(payout *model.Payout, from string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", payout, from)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockPayoutRepositoryMockRecorder) UpdateStatus(payout, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockPayoutRepository)(nil).UpdateStatus), payout, from)
+}
+
+// WithTx mocks base method.
+func (m *MockPayoutRepository) WithTx(tx repository.Tx) repository.PayoutRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.PayoutRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockPayoutRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockPayoutRepository)(nil).WithTx), tx)
+}
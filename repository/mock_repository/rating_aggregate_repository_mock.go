@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/rating_aggregate_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRatingAggregateRepository is a mock of RatingAggregateRepository interface.
+type MockRatingAggregateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRatingAggregateRepositoryMockRecorder
+}
+
+// MockRatingAggregateRepositoryMockRecorder is the mock recorder for MockRatingAggregateRepository.
+type MockRatingAggregateRepositoryMockRecorder struct {
+	mock *MockRatingAggregateRepository
+}
+
+// NewMockRatingAggregateRepository creates a new mock instance.
+func NewMockRatingAggregateRepository(ctrl *gomock.Controller) *MockRatingAggregateRepository {
+	mock := &MockRatingAggregateRepository{ctrl: ctrl}
+	mock.recorder = &MockRatingAggregateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRatingAggregateRepository) EXPECT() *MockRatingAggregateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockRatingAggregateRepository) Find(aggregate *model.RatingAggregate, ratedType string, ratedID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Find", aggregate, ratedType, ratedID)
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockRatingAggregateRepositoryMockRecorder) Find(aggregate, ratedType, ratedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockRatingAggregateRepository)(nil).Find), aggregate, ratedType, ratedID)
+}
+
+// Increment mocks base method.
+func (m *MockRatingAggregateRepository) Increment(aggregate *model.RatingAggregate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Increment", aggregate)
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockRatingAggregateRepositoryMockRecorder) Increment(aggregate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockRatingAggregateRepository)(nil).Increment), aggregate)
+}
@@ -0,0 +1,60 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/service_cooccurrence_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockServiceCooccurrenceRepository is a mock of ServiceCooccurrenceRepository interface.
+type MockServiceCooccurrenceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceCooccurrenceRepositoryMockRecorder
+}
+
+// MockServiceCooccurrenceRepositoryMockRecorder is the mock recorder for MockServiceCooccurrenceRepository.
+type MockServiceCooccurrenceRepositoryMockRecorder struct {
+	mock *MockServiceCooccurrenceRepository
+}
+
+// NewMockServiceCooccurrenceRepository creates a new mock instance.
+func NewMockServiceCooccurrenceRepository(ctrl *gomock.Controller) *MockServiceCooccurrenceRepository {
+	mock := &MockServiceCooccurrenceRepository{ctrl: ctrl}
+	mock.recorder = &MockServiceCooccurrenceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceCooccurrenceRepository) EXPECT() *MockServiceCooccurrenceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRelatedServiceIDs mocks base method.
+func (m *MockServiceCooccurrenceRepository) GetRelatedServiceIDs(serviceID uint, limit int) []uint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedServiceIDs", serviceID, limit)
+	ret0, _ := ret[0].([]uint)
+	return ret0
+}
+
+// GetRelatedServiceIDs indicates an expected call of GetRelatedServiceIDs.
+func (mr *MockServiceCooccurrenceRepositoryMockRecorder) GetRelatedServiceIDs(serviceID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedServiceIDs", reflect.TypeOf((*MockServiceCooccurrenceRepository)(nil).GetRelatedServiceIDs), serviceID, limit)
+}
+
+// Refresh mocks base method.
+func (m *MockServiceCooccurrenceRepository) Refresh() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Refresh")
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockServiceCooccurrenceRepositoryMockRecorder) Refresh() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockServiceCooccurrenceRepository)(nil).Refresh))
+}
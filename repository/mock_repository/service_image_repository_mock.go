@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/service_image_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockServiceImageRepository is a mock of ServiceImageRepository interface.
+type MockServiceImageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceImageRepositoryMockRecorder
+}
+
+// MockServiceImageRepositoryMockRecorder is the mock recorder for MockServiceImageRepository.
+type MockServiceImageRepositoryMockRecorder struct {
+	mock *MockServiceImageRepository
+}
+
+// NewMockServiceImageRepository creates a new mock instance.
+func NewMockServiceImageRepository(ctrl *gomock.Controller) *MockServiceImageRepository {
+	mock := &MockServiceImageRepository{ctrl: ctrl}
+	mock.recorder = &MockServiceImageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceImageRepository) EXPECT() *MockServiceImageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockServiceImageRepository) Delete(image *model.ServiceImage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", image)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceImageRepositoryMockRecorder) Delete(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceImageRepository)(nil).Delete), image)
+}
+
+// Find mocks base method.
+func (m *MockServiceImageRepository) Find(image *model.ServiceImage, serviceID uint, id string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Find", image, serviceID, id)
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockServiceImageRepositoryMockRecorder) Find(image, serviceID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockServiceImageRepository)(nil).Find), image, serviceID, id)
+}
+
+// Get mocks base method.
+func (m *MockServiceImageRepository) Get(images *[]model.ServiceImage, serviceID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", images, serviceID)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServiceImageRepositoryMockRecorder) Get(images, serviceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceImageRepository)(nil).Get), images, serviceID)
+}
+
+// Save mocks base method.
+func (m *MockServiceImageRepository) Save(image *model.ServiceImage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Save", image)
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockServiceImageRepositoryMockRecorder) Save(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockServiceImageRepository)(nil).Save), image)
+}
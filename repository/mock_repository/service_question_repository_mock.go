@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/service_question_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockServiceQuestionRepository is a mock of ServiceQuestionRepository interface.
+type MockServiceQuestionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceQuestionRepositoryMockRecorder
+}
+
+// MockServiceQuestionRepositoryMockRecorder is the mock recorder for MockServiceQuestionRepository.
+type MockServiceQuestionRepositoryMockRecorder struct {
+	mock *MockServiceQuestionRepository
+}
+
+// NewMockServiceQuestionRepository creates a new mock instance.
+func NewMockServiceQuestionRepository(ctrl *gomock.Controller) *MockServiceQuestionRepository {
+	mock := &MockServiceQuestionRepository{ctrl: ctrl}
+	mock.recorder = &MockServiceQuestionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceQuestionRepository) EXPECT() *MockServiceQuestionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockServiceQuestionRepository) Find(question *model.ServiceQuestion, serviceID uint, id string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Find", question, serviceID, id)
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockServiceQuestionRepositoryMockRecorder) Find(question, serviceID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockServiceQuestionRepository)(nil).Find), question, serviceID, id)
+}
+
+// Get mocks base method.
+func (m *MockServiceQuestionRepository) Get(questions *[]model.ServiceQuestion, serviceID uint, status string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", questions, serviceID, status)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServiceQuestionRepositoryMockRecorder) Get(questions, serviceID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceQuestionRepository)(nil).Get), questions, serviceID, status)
+}
+
+// Save mocks base method.
+func (m *MockServiceQuestionRepository) Save(question *model.ServiceQuestion) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Save", question)
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockServiceQuestionRepositoryMockRecorder) Save(question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockServiceQuestionRepository)(nil).Save), question)
+}
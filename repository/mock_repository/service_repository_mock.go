@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	model "github.com/andikabahari/eoplatform/model"
+	repository "github.com/andikabahari/eoplatform/repository"
 	request "github.com/andikabahari/eoplatform/request"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,10 +36,40 @@ func (m *MockServiceRepository) EXPECT() *MockServiceRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountForCategory mocks base method.
+func (m *MockServiceRepository) CountForCategory(categoryID uint) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountForCategory", categoryID)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// CountForCategory indicates an expected call of CountForCategory.
+func (mr *MockServiceRepositoryMockRecorder) CountForCategory(categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountForCategory", reflect.TypeOf((*MockServiceRepository)(nil).CountForCategory), categoryID)
+}
+
+// CountOrders mocks base method.
+func (m *MockServiceRepository) CountOrders(serviceID uint) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOrders", serviceID)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// CountOrders indicates an expected call of CountOrders.
+func (mr *MockServiceRepositoryMockRecorder) CountOrders(serviceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOrders", reflect.TypeOf((*MockServiceRepository)(nil).CountOrders), serviceID)
+}
+
 // Create mocks base method.
-func (m *MockServiceRepository) Create(service *model.Service) {
+func (m *MockServiceRepository) Create(service *model.Service) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Create", service)
+	ret := m.ctrl.Call(m, "Create", service)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Create indicates an expected call of Create.
@@ -71,22 +102,62 @@ func (mr *MockServiceRepositoryMockRecorder) Find(service, id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockServiceRepository)(nil).Find), service, id)
 }
 
+// FindByExternalID mocks base method.
+func (m *MockServiceRepository) FindByExternalID(service *model.Service, userID uint, externalID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FindByExternalID", service, userID, externalID)
+}
+
+// FindByExternalID indicates an expected call of FindByExternalID.
+func (mr *MockServiceRepositoryMockRecorder) FindByExternalID(service, userID, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByExternalID", reflect.TypeOf((*MockServiceRepository)(nil).FindByExternalID), service, userID, externalID)
+}
+
 // Get mocks base method.
-func (m *MockServiceRepository) Get(services *[]model.Service, keyword string) {
+func (m *MockServiceRepository) Get(services *[]model.Service, filter *model.ServiceFilter) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Get", services, keyword)
+	m.ctrl.Call(m, "Get", services, filter)
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockServiceRepositoryMockRecorder) Get(services, keyword interface{}) *gomock.Call {
+func (mr *MockServiceRepositoryMockRecorder) Get(services, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceRepository)(nil).Get), services, filter)
+}
+
+// GetFacets mocks base method.
+func (m *MockServiceRepository) GetFacets(facets *model.ServiceFacets, filter *model.ServiceFilter) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetFacets", facets, filter)
+}
+
+// GetFacets indicates an expected call of GetFacets.
+func (mr *MockServiceRepositoryMockRecorder) GetFacets(facets, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceRepository)(nil).Get), services, keyword)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacets", reflect.TypeOf((*MockServiceRepository)(nil).GetFacets), facets, filter)
+}
+
+// UnsetDeletedCategory mocks base method.
+func (m *MockServiceRepository) UnsetDeletedCategory(categoryID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetDeletedCategory", categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetDeletedCategory indicates an expected call of UnsetDeletedCategory.
+func (mr *MockServiceRepositoryMockRecorder) UnsetDeletedCategory(categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetDeletedCategory", reflect.TypeOf((*MockServiceRepository)(nil).UnsetDeletedCategory), categoryID)
 }
 
 // Update mocks base method.
-func (m *MockServiceRepository) Update(service *model.Service, req *request.UpdateServiceRequest) {
+func (m *MockServiceRepository) Update(service *model.Service, req *request.UpdateServiceRequest) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Update", service, req)
+	ret := m.ctrl.Call(m, "Update", service, req)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Update indicates an expected call of Update.
@@ -94,3 +165,29 @@ func (mr *MockServiceRepositoryMockRecorder) Update(service, req interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockServiceRepository)(nil).Update), service, req)
 }
+
+// UpdateStatus mocks base method.
+func (m *MockServiceRepository) UpdateStatus(service *model.Service, status string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateStatus", service, status)
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockServiceRepositoryMockRecorder) UpdateStatus(service, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockServiceRepository)(nil).UpdateStatus), service, status)
+}
+
+// WithTx mocks base method.
+func (m *MockServiceRepository) WithTx(tx repository.Tx) repository.ServiceRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.ServiceRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockServiceRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockServiceRepository)(nil).WithTx), tx)
+}
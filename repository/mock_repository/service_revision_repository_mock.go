@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/service_revision_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	repository "github.com/andikabahari/eoplatform/repository"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockServiceRevisionRepository is a mock of ServiceRevisionRepository interface.
+type MockServiceRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceRevisionRepositoryMockRecorder
+}
+
+// MockServiceRevisionRepositoryMockRecorder is the mock recorder for MockServiceRevisionRepository.
+type MockServiceRevisionRepositoryMockRecorder struct {
+	mock *MockServiceRevisionRepository
+}
+
+// NewMockServiceRevisionRepository creates a new mock instance.
+func NewMockServiceRevisionRepository(ctrl *gomock.Controller) *MockServiceRevisionRepository {
+	mock := &MockServiceRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockServiceRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceRevisionRepository) EXPECT() *MockServiceRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockServiceRevisionRepository) Create(revision *model.ServiceRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceRevisionRepositoryMockRecorder) Create(revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockServiceRevisionRepository)(nil).Create), revision)
+}
+
+// FindLatest mocks base method.
+func (m *MockServiceRevisionRepository) FindLatest(revision *model.ServiceRevision, serviceID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FindLatest", revision, serviceID)
+}
+
+// FindLatest indicates an expected call of FindLatest.
+func (mr *MockServiceRevisionRepositoryMockRecorder) FindLatest(revision, serviceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatest", reflect.TypeOf((*MockServiceRevisionRepository)(nil).FindLatest), revision, serviceID)
+}
+
+// Get mocks base method.
+func (m *MockServiceRevisionRepository) Get(revisions *[]model.ServiceRevision, serviceID uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", revisions, serviceID)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServiceRevisionRepositoryMockRecorder) Get(revisions, serviceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceRevisionRepository)(nil).Get), revisions, serviceID)
+}
+
+// GetLatestIDs mocks base method.
+func (m *MockServiceRevisionRepository) GetLatestIDs(serviceIDs []uint) map[uint]uint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestIDs", serviceIDs)
+	ret0, _ := ret[0].(map[uint]uint)
+	return ret0
+}
+
+// GetLatestIDs indicates an expected call of GetLatestIDs.
+func (mr *MockServiceRevisionRepositoryMockRecorder) GetLatestIDs(serviceIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestIDs", reflect.TypeOf((*MockServiceRevisionRepository)(nil).GetLatestIDs), serviceIDs)
+}
+
+// WithTx mocks base method.
+func (m *MockServiceRevisionRepository) WithTx(tx repository.Tx) repository.ServiceRevisionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.ServiceRevisionRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockServiceRevisionRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockServiceRevisionRepository)(nil).WithTx), tx)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/webhook_notification_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	reflect "reflect"
+
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebhookNotificationRepository is a mock of WebhookNotificationRepository interface.
+type MockWebhookNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookNotificationRepositoryMockRecorder
+}
+
+// MockWebhookNotificationRepositoryMockRecorder is the mock recorder for MockWebhookNotificationRepository.
+type MockWebhookNotificationRepositoryMockRecorder struct {
+	mock *MockWebhookNotificationRepository
+}
+
+// NewMockWebhookNotificationRepository creates a new mock instance.
+func NewMockWebhookNotificationRepository(ctrl *gomock.Controller) *MockWebhookNotificationRepository {
+	mock := &MockWebhookNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookNotificationRepository) EXPECT() *MockWebhookNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockWebhookNotificationRepository) Claim(notification *model.WebhookNotification) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", notification)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockWebhookNotificationRepositoryMockRecorder) Claim(notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockWebhookNotificationRepository)(nil).Claim), notification)
+}
+
+// Create mocks base method.
+func (m *MockWebhookNotificationRepository) Create(notification *model.WebhookNotification) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", notification)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookNotificationRepositoryMockRecorder) Create(notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookNotificationRepository)(nil).Create), notification)
+}
+
+// Find mocks base method.
+func (m *MockWebhookNotificationRepository) Find(notification *model.WebhookNotification, id string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Find", notification, id)
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockWebhookNotificationRepositoryMockRecorder) Find(notification, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockWebhookNotificationRepository)(nil).Find), notification, id)
+}
+
+// FindByKey mocks base method.
+func (m *MockWebhookNotificationRepository) FindByKey(notification *model.WebhookNotification, transactionID, transactionStatus string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FindByKey", notification, transactionID, transactionStatus)
+}
+
+// FindByKey indicates an expected call of FindByKey.
+func (mr *MockWebhookNotificationRepositoryMockRecorder) FindByKey(notification, transactionID, transactionStatus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockWebhookNotificationRepository)(nil).FindByKey), notification, transactionID, transactionStatus)
+}
+
+// Get mocks base method.
+func (m *MockWebhookNotificationRepository) Get(notifications *[]model.WebhookNotification, status string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", notifications, status)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookNotificationRepositoryMockRecorder) Get(notifications, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookNotificationRepository)(nil).Get), notifications, status)
+}
+
+// Save mocks base method.
+func (m *MockWebhookNotificationRepository) Save(notification *model.WebhookNotification) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Save", notification)
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockWebhookNotificationRepositoryMockRecorder) Save(notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWebhookNotificationRepository)(nil).Save), notification)
+}
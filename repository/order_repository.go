@@ -14,8 +14,11 @@ type OrderRepository interface {
 	Find(order *model.Order, id string)
 	FindOnly(order *model.Order, id any)
 	Create(order *model.Order)
-	Delete(order *model.Order)
-	Save(order *model.Order)
+	Delete(order *model.Order) error
+	DeleteUnaccepted(order *model.Order) (bool, error)
+	Save(order *model.Order) error
+	GetCompletedCountForOrganizer(userID uint) int64
+	WithTx(tx Tx) OrderRepository
 }
 
 type orderRepository struct {
@@ -27,7 +30,7 @@ func NewOrderRepository(db *gorm.DB) OrderRepository {
 }
 
 func (r *orderRepository) GetOrdersForCustomer(orders *[]model.Order, userID uint) {
-	r.db.Debug().Preload("User").Preload("Services").Where("user_id = ?", userID).Find(orders)
+	r.db.Debug().Preload("User").Preload("Services").Preload("Items").Where("user_id = ?", userID).Find(orders)
 }
 
 func (r *orderRepository) GetOrdersForOrganizer(orders *[]model.Order, userID uint) {
@@ -37,7 +40,7 @@ func (r *orderRepository) GetOrdersForOrganizer(orders *[]model.Order, userID ui
 		"JOIN services s ON s.id=os.service_id " +
 		"WHERE u.id!=@UserID AND s.user_id=@ServiceUserID"
 
-	r.db.Debug().Preload("User").Preload("Services").Where("id IN (?)", r.db.Raw(query,
+	r.db.Debug().Preload("User").Preload("Services").Preload("Items").Where("id IN (?)", r.db.Raw(query,
 		sql.Named("UserID", userID),
 		sql.Named("ServiceUserID", userID),
 	)).Find(orders)
@@ -48,17 +51,42 @@ func (r *orderRepository) Create(order *model.Order) {
 }
 
 func (r *orderRepository) Find(order *model.Order, id string) {
-	r.db.Debug().Preload("User").Preload("Services").Where("id = ?", id).Find(order)
+	r.db.Debug().Preload("User").Preload("Services").Preload("Items").Where("id = ?", id).Find(order)
 }
 
 func (r *orderRepository) FindOnly(order *model.Order, id any) {
 	r.db.Debug().Where("id = ?", id).Find(order)
 }
 
-func (r *orderRepository) Delete(order *model.Order) {
-	r.db.Debug().Delete(order)
+func (r *orderRepository) Delete(order *model.Order) error {
+	return r.db.Debug().Delete(order).Error
 }
 
-func (r *orderRepository) Save(order *model.Order) {
-	r.db.Debug().Omit(clause.Associations).Save(order)
+// DeleteUnaccepted deletes the order if it has not been accepted, reporting
+// whether it was deleted.
+func (r *orderRepository) DeleteUnaccepted(order *model.Order) (bool, error) {
+	result := r.db.Debug().Where("is_accepted = ?", false).Delete(order)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *orderRepository) Save(order *model.Order) error {
+	return r.db.Debug().Omit(clause.Associations).Save(order).Error
+}
+
+func (r *orderRepository) GetCompletedCountForOrganizer(userID uint) int64 {
+	var count int64
+
+	query := "SELECT COUNT(DISTINCT o.id) FROM orders o " +
+		"JOIN order_services os ON os.order_id=o.id " +
+		"JOIN services s ON s.id=os.service_id " +
+		"WHERE s.user_id=? AND o.is_completed>0 AND o.deleted_at IS NULL"
+
+	r.db.Debug().Raw(query, userID).Scan(&count)
+
+	return count
+}
+
+func (r *orderRepository) WithTx(tx Tx) OrderRepository {
+	return &orderRepository{tx.db}
 }
@@ -34,6 +34,8 @@ func (s *orderRepositorySuite) TestGetOrdersForCustomer() {
 	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 	query = regexp.QuoteMeta("SELECT * FROM `orders`")
 	s.mock.ExpectQuery(query).WillReturnRows(rows)
+	query = regexp.QuoteMeta("SELECT * FROM `order_items`")
+	s.mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id"}))
 	query = regexp.QuoteMeta("SELECT * FROM `order_services`")
 	s.mock.ExpectQuery(query).WillReturnRows(rows)
 	s.repository.GetOrdersForCustomer(&[]model.Order{}, 1)
@@ -44,6 +46,8 @@ func (s *orderRepositorySuite) TestGetOrdersForOrganizer() {
 	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 	query = regexp.QuoteMeta("SELECT * FROM `orders` WHERE id IN (SELECT DISTINCT o.id FROM orders o JOIN users u ON u.id=o.user_id JOIN order_services os ON os.order_id=o.id JOIN services s ON s.id=os.service_id WHERE u.id!=? AND s.user_id=?) AND `orders`.`deleted_at` IS NULL")
 	s.mock.ExpectQuery(query).WillReturnRows(rows)
+	query = regexp.QuoteMeta("SELECT * FROM `order_items`")
+	s.mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id"}))
 	query = regexp.QuoteMeta("SELECT * FROM `order_services`")
 	s.mock.ExpectQuery(query).WillReturnRows(rows)
 	s.repository.GetOrdersForOrganizer(&[]model.Order{}, 1)
@@ -54,6 +58,8 @@ func (s *orderRepositorySuite) TestFind() {
 	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 	query = regexp.QuoteMeta("SELECT * FROM `orders`")
 	s.mock.ExpectQuery(query).WithArgs("1").WillReturnRows(rows)
+	query = regexp.QuoteMeta("SELECT * FROM `order_items`")
+	s.mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id"}))
 	query = regexp.QuoteMeta("SELECT * FROM `order_services`")
 	s.mock.ExpectQuery(query).WillReturnRows(rows)
 	s.repository.Find(&model.Order{}, "1")
@@ -83,6 +89,17 @@ func (s *orderRepositorySuite) TestDelete() {
 	s.repository.Delete(&model.Order{Model: gorm.Model{ID: 1}})
 }
 
+func (s *orderRepositorySuite) TestDeleteUnaccepted() {
+	query := regexp.QuoteMeta("UPDATE `orders` SET `deleted_at`=? WHERE is_accepted = ? AND `orders`.`id` = ? AND `orders`.`deleted_at` IS NULL")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(sqlmock.AnyArg(), false, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	deleted, err := s.repository.DeleteUnaccepted(&model.Order{Model: gorm.Model{ID: 1}})
+	s.NoError(err)
+	s.False(deleted)
+}
+
 func (s *orderRepositorySuite) TestSave() {
 	query := regexp.QuoteMeta("INSERT INTO `orders`")
 	s.mock.ExpectBegin()
@@ -90,3 +107,10 @@ func (s *orderRepositorySuite) TestSave() {
 	s.mock.ExpectCommit()
 	s.repository.Save(&model.Order{})
 }
+
+func (s *orderRepositorySuite) TestGetCompletedCountForOrganizer() {
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(3)
+	query := regexp.QuoteMeta("SELECT COUNT(DISTINCT o.id) FROM orders o JOIN order_services os ON os.order_id=o.id JOIN services s ON s.id=os.service_id WHERE s.user_id=? AND o.is_completed>0 AND o.deleted_at IS NULL")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+	s.Equal(int64(3), s.repository.GetCompletedCountForOrganizer(1))
+}
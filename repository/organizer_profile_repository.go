@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+)
+
+type OrganizerProfileRepository interface {
+	FindByUserID(profile *model.OrganizerProfile, userID uint)
+	Save(profile *model.OrganizerProfile)
+}
+
+type organizerProfileRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizerProfileRepository(db *gorm.DB) OrganizerProfileRepository {
+	return &organizerProfileRepository{db}
+}
+
+func (r *organizerProfileRepository) FindByUserID(profile *model.OrganizerProfile, userID uint) {
+	r.db.Debug().Where("user_id = ?", userID).Find(profile)
+}
+
+func (r *organizerProfileRepository) Save(profile *model.OrganizerProfile) {
+	r.db.Debug().Save(profile)
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+)
+
+type organizerProfileRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository OrganizerProfileRepository
+}
+
+func (s *organizerProfileRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewOrganizerProfileRepository(gorm)
+}
+
+func TestOrganizerProfileRepositorySuite(t *testing.T) {
+	suite.Run(t, new(organizerProfileRepositorySuite))
+}
+
+func (s *organizerProfileRepositorySuite) TestFindByUserID() {
+	query := regexp.QuoteMeta("SELECT * FROM `organizer_profiles` WHERE user_id = ?")
+	rows := sqlmock.NewRows([]string{"id", "social_links"}).AddRow(1, `{"instagram":"https://instagram.com/eo"}`)
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+	profile := model.OrganizerProfile{}
+	s.repository.FindByUserID(&profile, 1)
+	s.Equal("https://instagram.com/eo", profile.SocialLinks["instagram"])
+}
+
+func (s *organizerProfileRepositorySuite) TestSave() {
+	query := regexp.QuoteMeta("INSERT INTO `organizer_profiles`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Save(&model.OrganizerProfile{})
+}
@@ -1,16 +1,24 @@
 package repository
 
 import (
+	"database/sql"
+
 	"github.com/andikabahari/eoplatform/model"
 	"github.com/andikabahari/eoplatform/request"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PaymentRepository interface {
 	Create(payment *model.Payment)
-	Update(payment *model.Payment, req *request.MidtransTransactionNotificationRequest)
+	Update(payment *model.Payment, req *request.MidtransTransactionNotificationRequest) error
 	GetOnlyByOrderID(payments *[]model.Payment, orderID any)
 	FindOnlyByOrderID(payment *model.Payment, orderID any)
+	LockByOrderID(payment *model.Payment, orderID any) error
+	Save(payment *model.Payment) error
+	GetEarnings(payments *[]model.Payment, userID uint)
+	GetRevenue(revenues *[]model.Revenue, from, to string)
+	WithTx(tx Tx) PaymentRepository
 }
 
 type paymentRepository struct {
@@ -25,10 +33,13 @@ func (r *paymentRepository) Create(payment *model.Payment) {
 	r.db.Debug().Omit("Order").Save(payment)
 }
 
-func (r *paymentRepository) Update(payment *model.Payment, req *request.MidtransTransactionNotificationRequest) {
+func (r *paymentRepository) Update(payment *model.Payment, req *request.MidtransTransactionNotificationRequest) error {
 	payment.Status = req.Status
+	if req.PaymentType != "" {
+		payment.Method = req.PaymentType
+	}
 
-	r.db.Debug().Omit("Order").Save(payment)
+	return r.db.Debug().Omit("Order").Save(payment).Error
 }
 
 func (r *paymentRepository) GetOnlyByOrderID(payments *[]model.Payment, orderID any) {
@@ -38,3 +49,47 @@ func (r *paymentRepository) GetOnlyByOrderID(payments *[]model.Payment, orderID
 func (r *paymentRepository) FindOnlyByOrderID(payment *model.Payment, orderID any) {
 	r.db.Debug().Where("order_id = ?", orderID).Find(payment)
 }
+
+// LockByOrderID finds the order's payment and locks its row until the
+// transaction the repository is bound to ends. Whatever settles a payment or
+// releases it from escrow takes this lock first, so that it is only done
+// once.
+func (r *paymentRepository) LockByOrderID(payment *model.Payment, orderID any) error {
+	return r.db.Debug().Clauses(clause.Locking{Strength: "UPDATE"}).Where("order_id = ?", orderID).Find(payment).Error
+}
+
+func (r *paymentRepository) Save(payment *model.Payment) error {
+	return r.db.Debug().Omit("Order").Save(payment).Error
+}
+
+func (r *paymentRepository) GetEarnings(payments *[]model.Payment, userID uint) {
+	query := "SELECT DISTINCT os.order_id FROM order_services os " +
+		"JOIN services s ON s.id=os.service_id " +
+		"WHERE s.user_id=@UserID"
+
+	r.db.Debug().Where("status = ? AND order_id IN (?)", "success", r.db.Raw(query,
+		sql.Named("UserID", userID),
+	)).Order("id DESC").Find(payments)
+}
+
+func (r *paymentRepository) GetRevenue(revenues *[]model.Revenue, from, to string) {
+	query := "SELECT DISTINCT os.order_id, s.user_id FROM order_services os " +
+		"JOIN services s ON s.id=os.service_id"
+
+	db := r.db.Debug().Table("payments p").
+		Select("t.user_id, SUM(p.amount) AS gross, SUM(p.commission) AS commission, SUM(p.earning) AS earning").
+		Joins("JOIN (?) t ON t.order_id=p.order_id", r.db.Raw(query)).
+		Where("p.status = ? AND p.deleted_at IS NULL", "success")
+	if from != "" {
+		db = db.Where("p.updated_at >= ?", from)
+	}
+	if to != "" {
+		db = db.Where("p.updated_at < ?", to)
+	}
+
+	db.Group("t.user_id").Scan(revenues)
+}
+
+func (r *paymentRepository) WithTx(tx Tx) PaymentRepository {
+	return &paymentRepository{tx.db}
+}
@@ -58,3 +58,37 @@ func (s *paymentRepositorySuite) TestFindOnlyByOrderID() {
 	s.mock.ExpectQuery(query).WillReturnRows(rows)
 	s.repository.FindOnlyByOrderID(&model.Payment{}, 1)
 }
+
+func (s *paymentRepositorySuite) TestLockByOrderID() {
+	rows := sqlmock.NewRows([]string{"id", "order_id", "status"}).AddRow(1, 1, "pending")
+	query := regexp.QuoteMeta("SELECT * FROM `payments` WHERE order_id = ? AND `payments`.`deleted_at` IS NULL FOR UPDATE")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+
+	payment := model.Payment{}
+	s.NoError(s.repository.LockByOrderID(&payment, 1))
+	s.Equal("pending", payment.Status)
+}
+
+func (s *paymentRepositorySuite) TestSave() {
+	query := regexp.QuoteMeta("INSERT INTO `payments`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Save(&model.Payment{})
+}
+
+func (s *paymentRepositorySuite) TestGetEarnings() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `payments` WHERE (status = ? AND order_id IN (SELECT DISTINCT os.order_id FROM order_services os JOIN services s ON s.id=os.service_id WHERE s.user_id=?))")
+	s.mock.ExpectQuery(query).WithArgs("success", 1).WillReturnRows(rows)
+	s.repository.GetEarnings(&[]model.Payment{}, 1)
+}
+
+func (s *paymentRepositorySuite) TestGetRevenue() {
+	rows := sqlmock.NewRows([]string{"user_id", "gross", "commission", "earning"}).AddRow(1, 1000, 100, 900)
+	query := regexp.QuoteMeta("SELECT t.user_id, SUM(p.amount) AS gross, SUM(p.commission) AS commission, SUM(p.earning) AS earning FROM payments p JOIN (SELECT DISTINCT os.order_id, s.user_id FROM order_services os JOIN services s ON s.id=os.service_id) t ON t.order_id=p.order_id WHERE (p.status = ? AND p.deleted_at IS NULL) AND p.updated_at >= ? GROUP BY `t`.`user_id`")
+	s.mock.ExpectQuery(query).WithArgs("success", "2022-01-01").WillReturnRows(rows)
+	revenues := []model.Revenue{}
+	s.repository.GetRevenue(&revenues, "2022-01-01", "")
+	s.Len(revenues, 1)
+}
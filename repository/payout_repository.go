@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PayoutRepository interface {
+	Get(payouts *[]model.Payout, status string)
+	GetForUser(payouts *[]model.Payout, userID uint)
+	Find(payout *model.Payout, id string)
+	Create(payout *model.Payout) error
+	UpdateStatus(payout *model.Payout, from string) (bool, error)
+	WithTx(tx Tx) PayoutRepository
+}
+
+type payoutRepository struct {
+	db *gorm.DB
+}
+
+func NewPayoutRepository(db *gorm.DB) PayoutRepository {
+	return &payoutRepository{db}
+}
+
+func (r *payoutRepository) Get(payouts *[]model.Payout, status string) {
+	if status != "" {
+		r.db.Debug().Preload("User").Preload("BankAccount").Where("status = ?", status).Find(payouts)
+	} else {
+		r.db.Debug().Preload("User").Preload("BankAccount").Find(payouts)
+	}
+}
+
+func (r *payoutRepository) GetForUser(payouts *[]model.Payout, userID uint) {
+	r.db.Debug().Preload("BankAccount").Where("user_id = ?", userID).Order("id DESC").Find(payouts)
+}
+
+func (r *payoutRepository) Find(payout *model.Payout, id string) {
+	r.db.Debug().Preload("User").Preload("BankAccount").Where("id = ?", id).Find(payout)
+}
+
+func (r *payoutRepository) Create(payout *model.Payout) error {
+	return r.db.Debug().Omit(clause.Associations).Save(payout).Error
+}
+
+// UpdateStatus saves the payout's status if it is still from, reporting
+// whether it was.
+func (r *payoutRepository) UpdateStatus(payout *model.Payout, from string) (bool, error) {
+	result := r.db.Debug().Model(payout).Omit(clause.Associations).Where("status = ?", from).Update("status", payout.Status)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *payoutRepository) WithTx(tx Tx) PayoutRepository {
+	return &payoutRepository{tx.db}
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type payoutRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository PayoutRepository
+}
+
+func (s *payoutRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewPayoutRepository(gorm)
+}
+
+func TestPayoutRepositorySuite(t *testing.T) {
+	suite.Run(t, new(payoutRepositorySuite))
+}
+
+func (s *payoutRepositorySuite) TestGet() {
+	var query string
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query = regexp.QuoteMeta("SELECT * FROM `payouts`")
+	s.mock.ExpectQuery(query).WillReturnRows(rows)
+	query = regexp.QuoteMeta("SELECT * FROM `payouts` WHERE status = ?")
+	s.mock.ExpectQuery(query).WithArgs("pending").WillReturnRows(rows)
+	s.repository.Get(&[]model.Payout{}, "")
+	s.repository.Get(&[]model.Payout{}, "pending")
+}
+
+func (s *payoutRepositorySuite) TestGetForUser() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `payouts` WHERE user_id = ?")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+	s.repository.GetForUser(&[]model.Payout{}, 1)
+}
+
+func (s *payoutRepositorySuite) TestFind() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `payouts`")
+	s.mock.ExpectQuery(query).WithArgs("1").WillReturnRows(rows)
+	s.repository.Find(&model.Payout{}, "1")
+}
+
+func (s *payoutRepositorySuite) TestCreate() {
+	query := regexp.QuoteMeta("INSERT INTO `payouts`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Create(&model.Payout{})
+}
+
+func (s *payoutRepositorySuite) TestUpdateStatus() {
+	query := regexp.QuoteMeta("UPDATE `payouts` SET `status`=?,`updated_at`=? WHERE status = ? AND `payouts`.`deleted_at` IS NULL AND `id` = ?")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs("paid", sqlmock.AnyArg(), "pending", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs("paid", sqlmock.AnyArg(), "pending", 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	updated, err := s.repository.UpdateStatus(&model.Payout{Model: gorm.Model{ID: 1}, Status: "paid"}, "pending")
+	s.NoError(err)
+	s.True(updated)
+
+	updated, err = s.repository.UpdateStatus(&model.Payout{Model: gorm.Model{ID: 1}, Status: "paid"}, "pending")
+	s.NoError(err)
+	s.False(updated)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RatingAggregateRepository interface {
+	Find(aggregate *model.RatingAggregate, ratedType string, ratedID uint)
+	Increment(aggregate *model.RatingAggregate)
+}
+
+type ratingAggregateRepository struct {
+	db *gorm.DB
+}
+
+func NewRatingAggregateRepository(db *gorm.DB) RatingAggregateRepository {
+	return &ratingAggregateRepository{db}
+}
+
+func (r *ratingAggregateRepository) Find(aggregate *model.RatingAggregate, ratedType string, ratedID uint) {
+	r.db.Debug().Where("rated_type = ? AND rated_id = ?", ratedType, ratedID).Find(aggregate)
+}
+
+// Increment adds the counts in aggregate to the stored aggregate of the same
+// rated type and ID, creating it if there is none yet. The addition happens
+// in the database so concurrent feedbacks are not lost.
+func (r *ratingAggregateRepository) Increment(aggregate *model.RatingAggregate) {
+	assignments := map[string]any{"updated_at": gorm.Expr("VALUES(updated_at)")}
+	for _, column := range []string{
+		"count", "total", "star1", "star2", "star3", "star4", "star5",
+		"positive_count", "negative_count",
+	} {
+		assignments[column] = gorm.Expr(column + " + VALUES(" + column + ")")
+	}
+
+	r.db.Debug().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "rated_type"}, {Name: "rated_id"}},
+		DoUpdates: clause.Assignments(assignments),
+	}).Create(aggregate)
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+)
+
+type ratingAggregateRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository RatingAggregateRepository
+}
+
+func (s *ratingAggregateRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewRatingAggregateRepository(gorm)
+}
+
+func TestRatingAggregateRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ratingAggregateRepositorySuite))
+}
+
+func (s *ratingAggregateRepositorySuite) TestFind() {
+	query := regexp.QuoteMeta("SELECT * FROM `rating_aggregates` WHERE (rated_type = ? AND rated_id = ?)")
+	rows := sqlmock.NewRows([]string{"id", "count", "total"}).AddRow(1, 2, 9)
+	s.mock.ExpectQuery(query).WithArgs(model.RatedTypeOrganizer, 1).WillReturnRows(rows)
+	aggregate := model.RatingAggregate{}
+	s.repository.Find(&aggregate, model.RatedTypeOrganizer, 1)
+	s.Equal(4.5, aggregate.Average())
+}
+
+func (s *ratingAggregateRepositorySuite) TestIncrement() {
+	query := regexp.QuoteMeta("INSERT INTO `rating_aggregates`")
+	update := regexp.QuoteMeta("ON DUPLICATE KEY UPDATE `count`=count + VALUES(count)")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query + ".*" + update).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Increment(&model.RatingAggregate{RatedType: model.RatedTypeService, RatedID: 1, Count: 1})
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+)
+
+type ServiceCooccurrenceRepository interface {
+	GetRelatedServiceIDs(serviceID uint, limit int) []uint
+	Refresh()
+}
+
+type serviceCooccurrenceRepository struct {
+	db *gorm.DB
+}
+
+func NewServiceCooccurrenceRepository(db *gorm.DB) ServiceCooccurrenceRepository {
+	return &serviceCooccurrenceRepository{db}
+}
+
+// GetRelatedServiceIDs returns the published services most often booked for
+// the same event as the service, most often first.
+func (r *serviceCooccurrenceRepository) GetRelatedServiceIDs(serviceID uint, limit int) []uint {
+	serviceIDs := make([]uint, 0)
+	r.db.Debug().Model(&model.ServiceCooccurrence{}).
+		Joins("JOIN services s ON s.id=service_cooccurrences.related_service_id AND s.deleted_at IS NULL").
+		Where("service_cooccurrences.service_id = ? AND s.status = ?", serviceID, model.ServiceStatusPublished).
+		Order("service_cooccurrences.count DESC").
+		Order("service_cooccurrences.related_service_id").
+		Limit(limit).
+		Pluck("service_cooccurrences.related_service_id", &serviceIDs)
+
+	return serviceIDs
+}
+
+// Refresh recomputes every pair from the orders. An event is one customer's
+// orders for one day, since an order only holds services of one organizer.
+func (r *serviceCooccurrenceRepository) Refresh() {
+	query := "INSERT INTO service_cooccurrences " +
+		"(created_at, updated_at, service_id, related_service_id, count) " +
+		"SELECT NOW(3), NOW(3), a.service_id, b.service_id, " +
+		"COUNT(DISTINCT o1.user_id, DATE(o1.date_of_event)) " +
+		"FROM orders o1 " +
+		"JOIN order_services a ON a.order_id=o1.id " +
+		"JOIN orders o2 ON o2.user_id=o1.user_id AND DATE(o2.date_of_event)=DATE(o1.date_of_event) AND o2.deleted_at IS NULL " +
+		"JOIN order_services b ON b.order_id=o2.id AND b.service_id<>a.service_id " +
+		"WHERE o1.deleted_at IS NULL " +
+		"GROUP BY a.service_id, b.service_id"
+
+	r.db.Debug().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM service_cooccurrences").Error; err != nil {
+			return err
+		}
+		return tx.Exec(query).Error
+	})
+}
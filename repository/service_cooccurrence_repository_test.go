@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+)
+
+type serviceCooccurrenceRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository ServiceCooccurrenceRepository
+}
+
+func (s *serviceCooccurrenceRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewServiceCooccurrenceRepository(gorm)
+}
+
+func TestServiceCooccurrenceRepositorySuite(t *testing.T) {
+	suite.Run(t, new(serviceCooccurrenceRepositorySuite))
+}
+
+func (s *serviceCooccurrenceRepositorySuite) TestGetRelatedServiceIDs() {
+	query := regexp.QuoteMeta("SELECT `service_cooccurrences`.`related_service_id` FROM `service_cooccurrences` " +
+		"JOIN services s ON s.id=service_cooccurrences.related_service_id AND s.deleted_at IS NULL " +
+		"WHERE (service_cooccurrences.service_id = ? AND s.status = ?) AND `service_cooccurrences`.`deleted_at` IS NULL " +
+		"ORDER BY service_cooccurrences.count DESC,service_cooccurrences.related_service_id LIMIT 6")
+	rows := sqlmock.NewRows([]string{"related_service_id"}).AddRow(4).AddRow(2)
+	s.mock.ExpectQuery(query).WithArgs(1, "published").WillReturnRows(rows)
+	s.Equal([]uint{4, 2}, s.repository.GetRelatedServiceIDs(1, 6))
+}
+
+func (s *serviceCooccurrenceRepositorySuite) TestRefresh() {
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM service_cooccurrences")).WillReturnResult(sqlmock.NewResult(0, 3))
+	s.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO service_cooccurrences") + ".*" +
+		regexp.QuoteMeta("GROUP BY a.service_id, b.service_id")).WillReturnResult(sqlmock.NewResult(0, 4))
+	s.mock.ExpectCommit()
+	s.repository.Refresh()
+	s.NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+)
+
+type ServiceImageRepository interface {
+	Get(images *[]model.ServiceImage, serviceID uint)
+	Find(image *model.ServiceImage, serviceID uint, id string)
+	Save(image *model.ServiceImage)
+	Delete(image *model.ServiceImage)
+}
+
+type serviceImageRepository struct {
+	db *gorm.DB
+}
+
+func NewServiceImageRepository(db *gorm.DB) ServiceImageRepository {
+	return &serviceImageRepository{db}
+}
+
+func (r *serviceImageRepository) Get(images *[]model.ServiceImage, serviceID uint) {
+	r.db.Debug().Where("service_id = ?", serviceID).Order("position").Order("id").Find(images)
+}
+
+func (r *serviceImageRepository) Find(image *model.ServiceImage, serviceID uint, id string) {
+	r.db.Debug().Where("service_id = ? AND id = ?", serviceID, id).Find(image)
+}
+
+func (r *serviceImageRepository) Save(image *model.ServiceImage) {
+	r.db.Debug().Save(image)
+}
+
+func (r *serviceImageRepository) Delete(image *model.ServiceImage) {
+	r.db.Debug().Delete(image)
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type serviceImageRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository ServiceImageRepository
+}
+
+func (s *serviceImageRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewServiceImageRepository(gorm)
+}
+
+func TestServiceImageRepositorySuite(t *testing.T) {
+	suite.Run(t, new(serviceImageRepositorySuite))
+}
+
+func (s *serviceImageRepositorySuite) TestGet() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `service_images` WHERE service_id = ? AND `service_images`.`deleted_at` IS NULL ORDER BY position,id")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+	s.repository.Get(&[]model.ServiceImage{}, 1)
+}
+
+func (s *serviceImageRepositorySuite) TestFind() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `service_images` WHERE (service_id = ? AND id = ?)")
+	s.mock.ExpectQuery(query).WithArgs(1, "1").WillReturnRows(rows)
+	s.repository.Find(&model.ServiceImage{}, 1, "1")
+}
+
+func (s *serviceImageRepositorySuite) TestSave() {
+	var query string
+	query = regexp.QuoteMeta("INSERT INTO `service_images`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Save(&model.ServiceImage{})
+
+	query = regexp.QuoteMeta("UPDATE `service_images`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.repository.Save(&model.ServiceImage{Model: gorm.Model{ID: 1}})
+}
+
+func (s *serviceImageRepositorySuite) TestDelete() {
+	query := regexp.QuoteMeta("UPDATE `service_images` SET `deleted_at`=?")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.repository.Delete(&model.ServiceImage{Model: gorm.Model{ID: 1}})
+}
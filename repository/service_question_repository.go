@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+)
+
+type ServiceQuestionRepository interface {
+	Get(questions *[]model.ServiceQuestion, serviceID uint, status string)
+	Find(question *model.ServiceQuestion, serviceID uint, id string)
+	Save(question *model.ServiceQuestion)
+}
+
+type serviceQuestionRepository struct {
+	db *gorm.DB
+}
+
+func NewServiceQuestionRepository(db *gorm.DB) ServiceQuestionRepository {
+	return &serviceQuestionRepository{db}
+}
+
+func (r *serviceQuestionRepository) Get(questions *[]model.ServiceQuestion, serviceID uint, status string) {
+	r.db.Debug().Preload("User").Where("service_id = ? AND status = ?", serviceID, status).Order("id DESC").Find(questions)
+}
+
+func (r *serviceQuestionRepository) Find(question *model.ServiceQuestion, serviceID uint, id string) {
+	r.db.Debug().Preload("User").Where("service_id = ? AND id = ?", serviceID, id).Find(question)
+}
+
+func (r *serviceQuestionRepository) Save(question *model.ServiceQuestion) {
+	r.db.Debug().Omit("User").Save(question)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+)
+
+type serviceQuestionRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository ServiceQuestionRepository
+}
+
+func (s *serviceQuestionRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewServiceQuestionRepository(gorm)
+}
+
+func TestServiceQuestionRepositorySuite(t *testing.T) {
+	suite.Run(t, new(serviceQuestionRepositorySuite))
+}
+
+func (s *serviceQuestionRepositorySuite) TestGet() {
+	query := regexp.QuoteMeta("SELECT * FROM `service_questions` WHERE (service_id = ? AND status = ?) AND `service_questions`.`deleted_at` IS NULL ORDER BY id DESC")
+	rows := sqlmock.NewRows([]string{"id", "user_id"}).AddRow(1, 2)
+	s.mock.ExpectQuery(query).WithArgs(1, model.QuestionStatusPublished).WillReturnRows(rows)
+	query = regexp.QuoteMeta("SELECT * FROM `users` WHERE `users`.`id` = ? AND `users`.`deleted_at` IS NULL")
+	s.mock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	s.repository.Get(&[]model.ServiceQuestion{}, 1, model.QuestionStatusPublished)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *serviceQuestionRepositorySuite) TestFind() {
+	query := regexp.QuoteMeta("SELECT * FROM `service_questions` WHERE (service_id = ? AND id = ?)")
+	rows := sqlmock.NewRows([]string{"id"})
+	s.mock.ExpectQuery(query).WithArgs(1, "2").WillReturnRows(rows)
+	s.repository.Find(&model.ServiceQuestion{}, 1, "2")
+}
+
+func (s *serviceQuestionRepositorySuite) TestSave() {
+	query := regexp.QuoteMeta("INSERT INTO `service_questions`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Save(&model.ServiceQuestion{})
+}
@@ -6,14 +6,22 @@ import (
 	"github.com/andikabahari/eoplatform/model"
 	"github.com/andikabahari/eoplatform/request"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ServiceRepository interface {
-	Get(services *[]model.Service, keyword string)
+	Get(services *[]model.Service, filter *model.ServiceFilter)
+	GetFacets(facets *model.ServiceFacets, filter *model.ServiceFilter)
 	Find(service *model.Service, id string)
-	Create(service *model.Service)
-	Update(service *model.Service, req *request.UpdateServiceRequest)
+	FindByExternalID(service *model.Service, userID uint, externalID string)
+	Create(service *model.Service) error
+	Update(service *model.Service, req *request.UpdateServiceRequest) error
 	Delete(service *model.Service)
+	UpdateStatus(service *model.Service, status string)
+	CountOrders(serviceID uint) int64
+	CountForCategory(categoryID uint) int64
+	UnsetDeletedCategory(categoryID uint) error
+	WithTx(tx Tx) ServiceRepository
 }
 
 type serviceRepository struct {
@@ -24,33 +32,259 @@ func NewServiceRepository(db *gorm.DB) ServiceRepository {
 	return &serviceRepository{db}
 }
 
-func (r *serviceRepository) Get(services *[]model.Service, keyword string) {
-	if keyword != "" {
-		keyword = fmt.Sprintf("%%%s%%", keyword)
-		r.db.Debug().Preload("User").Where("name LIKE ? OR description LIKE ?", keyword, keyword).Find(services)
-	} else {
-		r.db.Debug().Preload("User").Find(services)
+func (r *serviceRepository) Get(services *[]model.Service, filter *model.ServiceFilter) {
+	db := r.search(r.db.Debug(), filter)
+	if joinsRatings(filter) {
+		db = db.Select("services.*")
 	}
+	db = db.Preload("User").Preload("Category").Preload("Tags").Preload("Addons", orderAddons).Preload("Images", orderImages).Preload("Rating").Preload("Variants", orderVariants)
+
+	switch filter.Sort {
+	case model.ServiceSortRelevance:
+		if len(filter.IDs) > 0 {
+			db = db.Clauses(clause.OrderBy{Expression: clause.Expr{
+				SQL:                "FIELD(services.id,?)",
+				Vars:               []any{filter.IDs},
+				WithoutParentheses: true,
+			}})
+		}
+	case model.ServiceSortNewest:
+		db = db.Order("services.id DESC")
+	case model.ServiceSortPriceAsc:
+		db = db.Order("cost").Order("services.id")
+	case model.ServiceSortPriceDesc:
+		db = db.Order("cost DESC").Order("services.id")
+	case model.ServiceSortRating:
+		db = db.Order("ra.total/ra.count DESC").Order("services.id")
+	case model.ServiceSortPopular:
+		db = db.Order("(SELECT COUNT(1) FROM order_services os " +
+			"JOIN orders o ON o.id=os.order_id " +
+			"WHERE os.service_id=services.id AND o.deleted_at IS NULL) DESC").Order("services.id")
+	}
+
+	if filter.Limit > 0 {
+		db = db.Limit(filter.Limit).Offset(filter.Offset)
+	}
+
+	db.Find(services)
+}
+
+var servicePriceRanges = []struct {
+	Min model.Money
+	Max model.Money
+}{
+	{0, 1_000_000},
+	{1_000_000, 5_000_000},
+	{5_000_000, 10_000_000},
+	{10_000_000, 0},
+}
+
+var serviceRatingSteps = []int{4, 3, 2, 1}
+
+func (r *serviceRepository) GetFacets(facets *model.ServiceFacets, filter *model.ServiceFilter) {
+	r.search(r.db.Debug().Model(&model.Service{}), filter).Count(&facets.Total)
+
+	matched := r.search(r.db.Model(&model.Service{}), filter).
+		Select("services.id", "services.user_id", "services.category_id")
+
+	facets.Categories = make([]model.FacetCount, 0)
+	r.db.Debug().Table("(?) AS s", matched).
+		Select("c.slug AS value, c.name AS label, COUNT(1) AS count").
+		Joins("JOIN categories c ON c.id=s.category_id").
+		Group("c.id, c.slug, c.name").
+		Order("c.name").
+		Scan(&facets.Categories)
+
+	facets.Organizers = make([]model.FacetCount, 0)
+	r.db.Debug().Table("(?) AS s", matched).
+		Select("u.id AS value, u.name AS label, COUNT(1) AS count").
+		Joins("JOIN users u ON u.id=s.user_id").
+		Group("u.id, u.name").
+		Order("u.name").
+		Scan(&facets.Organizers)
+
+	facets.Prices = make([]model.FacetCount, 0)
+	for _, priceRange := range servicePriceRanges {
+		facet := model.FacetCount{Value: fmt.Sprintf("%d-", priceRange.Min)}
+		db := r.search(r.db.Debug().Model(&model.Service{}), filter).Where("cost >= ?", priceRange.Min)
+		if priceRange.Max > 0 {
+			facet.Value += fmt.Sprint(priceRange.Max)
+			db = db.Where("cost < ?", priceRange.Max)
+		}
+		db.Count(&facet.Count)
+		facets.Prices = append(facets.Prices, facet)
+	}
+
+	facets.Ratings = make([]model.FacetCount, 0)
+	for _, rating := range serviceRatingSteps {
+		facet := model.FacetCount{Value: fmt.Sprint(rating)}
+		ratingFilter := *filter
+		if ratingFilter.MinRating < float64(rating) {
+			ratingFilter.MinRating = float64(rating)
+		}
+		r.search(r.db.Debug().Model(&model.Service{}), &ratingFilter).Count(&facet.Count)
+		facets.Ratings = append(facets.Ratings, facet)
+	}
+}
+
+// search narrows db down to the services matching filter.
+func (r *serviceRepository) search(db *gorm.DB, filter *model.ServiceFilter) *gorm.DB {
+	if joinsRatings(filter) {
+		db = db.Joins("LEFT JOIN rating_aggregates ra ON ra.rated_type=? AND ra.rated_id=services.id AND ra.deleted_at IS NULL", model.RatedTypeService)
+	}
+	if filter.IDs != nil {
+		db = db.Where("services.id IN ?", filter.IDs)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	if filter.Keyword != "" {
+		keyword := fmt.Sprintf("%%%s%%", filter.Keyword)
+		db = db.Where("name LIKE ? OR description LIKE ?", keyword, keyword)
+	}
+	if filter.CategoryIDs != nil {
+		db = db.Where("category_id IN ?", filter.CategoryIDs)
+	}
+	if filter.Tag != "" {
+		db = db.Where("services.id IN (?)", r.db.Table("service_tags st").
+			Select("st.service_id").
+			Joins("JOIN tags t ON t.id=st.tag_id").
+			Where("t.name = ?", filter.Tag),
+		)
+	}
+	if filter.MinPrice > 0 {
+		db = db.Where("cost >= ?", filter.MinPrice)
+	}
+	if filter.MaxPrice > 0 {
+		db = db.Where("cost <= ?", filter.MaxPrice)
+	}
+	if filter.OrganizerID > 0 {
+		db = db.Where("user_id = ?", filter.OrganizerID)
+	}
+	if filter.MinRating > 0 {
+		db = db.Where("ra.count > 0 AND ra.total >= ? * ra.count", filter.MinRating)
+	}
+	if filter.AvailableOn != nil {
+		db = db.Where("services.id NOT IN (?)", r.db.Table("order_services os").
+			Select("os.service_id").
+			Joins("JOIN orders o ON o.id=os.order_id").
+			Where("o.deleted_at IS NULL AND DATE(o.date_of_event) = ?", filter.AvailableOn.Format("2006-01-02")),
+		)
+	}
+
+	return db
+}
+
+// joinsRatings reports whether services are joined with their rating
+// aggregates, as ra, to be filtered or sorted by rating.
+func joinsRatings(filter *model.ServiceFilter) bool {
+	return filter.MinRating > 0 || filter.Sort == model.ServiceSortRating
 }
 
 func (r *serviceRepository) Find(service *model.Service, id string) {
-	r.db.Debug().Preload("User").Where("id = ?", id).Find(service)
+	r.db.Debug().Preload("User").Preload("Category").Preload("Tags").Preload("Addons", orderAddons).Preload("Images", orderImages).Preload("Rating").Preload("Variants", orderVariants).Where("id = ?", id).Find(service)
+}
+
+func (r *serviceRepository) FindByExternalID(service *model.Service, userID uint, externalID string) {
+	r.db.Debug().Preload("User").Preload("Category").Preload("Tags").Preload("Addons", orderAddons).Preload("Images", orderImages).Preload("Rating").Preload("Variants", orderVariants).Where("user_id = ? AND external_id = ?", userID, externalID).Find(service)
+}
+
+func orderImages(db *gorm.DB) *gorm.DB {
+	return db.Order("position").Order("id")
+}
+
+func orderVariants(db *gorm.DB) *gorm.DB {
+	return db.Order("price").Order("id")
+}
+
+func orderAddons(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
 }
 
-func (r *serviceRepository) Create(service *model.Service) {
-	r.db.Debug().Save(service)
+func (r *serviceRepository) Create(service *model.Service) error {
+	return r.db.Debug().Omit("Category", "Images", "Rating").Save(service).Error
 }
 
-func (r *serviceRepository) Update(service *model.Service, req *request.UpdateServiceRequest) {
+func (r *serviceRepository) Update(service *model.Service, req *request.UpdateServiceRequest) error {
 	service.Name = req.Name
 	service.Cost = req.Cost
 	service.Phone = req.Phone
 	service.Email = req.Email
 	service.Description = req.Description
 
-	r.db.Debug().Omit("User").Save(service)
+	if err := r.db.Debug().Omit("User", "Category", "Tags", "Images", "Variants", "Addons", "Rating").Save(service).Error; err != nil {
+		return err
+	}
+	if err := r.db.Debug().Model(service).Association("Tags").Replace(service.Tags); err != nil {
+		return err
+	}
+
+	// Variants and add-ons left out of the update are removed; orders keep
+	// their own copy of what they were placed with.
+	keep := make([]uint, 0)
+	for i := range service.Variants {
+		service.Variants[i].ServiceID = service.ID
+		if err := r.db.Debug().Save(&service.Variants[i]).Error; err != nil {
+			return err
+		}
+		keep = append(keep, service.Variants[i].ID)
+	}
+	if err := r.deleteOthers(&model.ServiceVariant{}, service.ID, keep); err != nil {
+		return err
+	}
+
+	keep = make([]uint, 0)
+	for i := range service.Addons {
+		service.Addons[i].ServiceID = service.ID
+		if err := r.db.Debug().Save(&service.Addons[i]).Error; err != nil {
+			return err
+		}
+		keep = append(keep, service.Addons[i].ID)
+	}
+
+	return r.deleteOthers(&model.ServiceAddon{}, service.ID, keep)
+}
+
+// deleteOthers deletes the rows of a service's child table whose IDs are
+// not in keep.
+func (r *serviceRepository) deleteOthers(value any, serviceID uint, keep []uint) error {
+	db := r.db.Debug().Where("service_id = ?", serviceID)
+	if len(keep) > 0 {
+		db = db.Where("id NOT IN ?", keep)
+	}
+	return db.Delete(value).Error
 }
 
 func (r *serviceRepository) Delete(service *model.Service) {
 	r.db.Debug().Delete(service)
 }
+
+func (r *serviceRepository) UpdateStatus(service *model.Service, status string) {
+	r.db.Debug().Model(service).Omit(clause.Associations).Update("status", status)
+}
+
+func (r *serviceRepository) CountOrders(serviceID uint) int64 {
+	var count int64
+	r.db.Debug().Table("order_services").Where("service_id = ?", serviceID).Count(&count)
+
+	return count
+}
+
+func (r *serviceRepository) CountForCategory(categoryID uint) int64 {
+	var count int64
+	r.db.Debug().Model(&model.Service{}).Where("category_id = ?", categoryID).Count(&count)
+
+	return count
+}
+
+// UnsetDeletedCategory takes the category off the deleted services that
+// were in it, so that it can be deleted.
+func (r *serviceRepository) UnsetDeletedCategory(categoryID uint) error {
+	return r.db.Debug().Unscoped().Model(&model.Service{}).
+		Where("category_id = ? AND deleted_at IS NOT NULL", categoryID).
+		Update("category_id", nil).Error
+}
+
+func (r *serviceRepository) WithTx(tx Tx) ServiceRepository {
+	return &serviceRepository{tx.db}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/andikabahari/eoplatform/model"
@@ -33,21 +34,115 @@ func TestServiceRepositorySuite(t *testing.T) {
 func (s *serviceRepositorySuite) TestGet() {
 	var query string
 	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	addons := regexp.QuoteMeta("SELECT * FROM `service_addons` WHERE `service_addons`.`service_id` = ? AND `service_addons`.`deleted_at` IS NULL ORDER BY id")
+	images := regexp.QuoteMeta("SELECT * FROM `service_images` WHERE `service_images`.`service_id` = ? AND `service_images`.`deleted_at` IS NULL ORDER BY position,id")
+	rating := regexp.QuoteMeta("SELECT * FROM `rating_aggregates` WHERE `rated_type` = ? AND `rating_aggregates`.`rated_id` = ? AND `rating_aggregates`.`deleted_at` IS NULL")
+	tags := regexp.QuoteMeta("SELECT * FROM `service_tags` WHERE `service_tags`.`service_id` = ?")
+	variants := regexp.QuoteMeta("SELECT * FROM `service_variants` WHERE `service_variants`.`service_id` = ? AND `service_variants`.`deleted_at` IS NULL ORDER BY price,id")
 	query = regexp.QuoteMeta("SELECT * FROM `services`")
 	s.mock.ExpectQuery(query).WillReturnRows(rows)
-	query = regexp.QuoteMeta("SELECT * FROM `services`")
-	s.mock.ExpectQuery(query).WillReturnRows(rows)
-	s.repository.Get(&[]model.Service{}, "")
-	s.repository.Get(&[]model.Service{}, "any")
+	s.mock.ExpectQuery(addons).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	s.mock.ExpectQuery(images).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	s.mock.ExpectQuery(rating).WithArgs("service", 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	s.mock.ExpectQuery(tags).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"service_id", "tag_id"}))
+	s.mock.ExpectQuery(variants).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	rows = sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query = regexp.QuoteMeta("SELECT * FROM `services` WHERE status = ? AND (name LIKE ? OR description LIKE ?) AND category_id IN (?,?) AND services.id IN (SELECT st.service_id FROM service_tags st JOIN tags t ON t.id=st.tag_id WHERE t.name = ?)")
+	s.mock.ExpectQuery(query).WithArgs("published", "%any%", "%any%", 1, 2, "outdoor").WillReturnRows(rows)
+	s.mock.ExpectQuery(addons).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	s.mock.ExpectQuery(images).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	s.mock.ExpectQuery(rating).WithArgs("service", 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	s.mock.ExpectQuery(tags).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"service_id", "tag_id"}))
+	s.mock.ExpectQuery(variants).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	s.repository.Get(&[]model.Service{}, &model.ServiceFilter{})
+	s.repository.Get(&[]model.Service{}, &model.ServiceFilter{Status: model.ServiceStatusPublished, Keyword: "any", CategoryIDs: []uint{1, 2}, Tag: "outdoor"})
+}
+
+func (s *serviceRepositorySuite) TestGetSearch() {
+	availableOn := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id"})
+	query := regexp.QuoteMeta("SELECT services.* FROM `services` " +
+		"LEFT JOIN rating_aggregates ra ON ra.rated_type=? AND ra.rated_id=services.id AND ra.deleted_at IS NULL " +
+		"WHERE cost >= ? AND cost <= ? AND user_id = ? " +
+		"AND (ra.count > 0 AND ra.total >= ? * ra.count) " +
+		"AND services.id NOT IN (SELECT os.service_id FROM order_services os JOIN orders o ON o.id=os.order_id WHERE o.deleted_at IS NULL AND DATE(o.date_of_event) = ?) " +
+		"AND `services`.`deleted_at` IS NULL " +
+		"ORDER BY ra.total/ra.count DESC,services.id LIMIT 10 OFFSET 20")
+	s.mock.ExpectQuery(query).WithArgs("service", 100, 200, 2, 4.0, "2024-05-01").WillReturnRows(rows)
+	s.repository.Get(&[]model.Service{}, &model.ServiceFilter{
+		MinPrice:    100,
+		MaxPrice:    200,
+		OrganizerID: 2,
+		MinRating:   4,
+		AvailableOn: &availableOn,
+		Sort:        model.ServiceSortRating,
+		Offset:      20,
+		Limit:       10,
+	})
+}
+
+func (s *serviceRepositorySuite) TestGetRelevance() {
+	rows := sqlmock.NewRows([]string{"id"})
+	query := regexp.QuoteMeta("SELECT * FROM `services` WHERE services.id IN (?,?) AND `services`.`deleted_at` IS NULL ORDER BY FIELD(services.id,?,?)")
+	s.mock.ExpectQuery(query).WithArgs(3, 1, 3, 1).WillReturnRows(rows)
+	s.repository.Get(&[]model.Service{}, &model.ServiceFilter{IDs: []uint{3, 1}, Sort: model.ServiceSortRelevance})
+}
+
+func (s *serviceRepositorySuite) TestGetFacets() {
+	var query string
+	count := func() *sqlmock.Rows { return sqlmock.NewRows([]string{"count"}).AddRow(1) }
+	query = regexp.QuoteMeta("SELECT count(*) FROM `services` WHERE user_id = ?")
+	s.mock.ExpectQuery(query).WithArgs(2).WillReturnRows(count())
+	query = regexp.QuoteMeta("SELECT c.slug AS value, c.name AS label, COUNT(1) AS count FROM (SELECT services.id,services.user_id,services.category_id FROM `services` WHERE user_id = ?")
+	s.mock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"value", "label", "count"}).AddRow("venue", "Venue", 1))
+	query = regexp.QuoteMeta("SELECT u.id AS value, u.name AS label, COUNT(1) AS count FROM (SELECT services.id,services.user_id,services.category_id FROM `services` WHERE user_id = ?")
+	s.mock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"value", "label", "count"}).AddRow("2", "Organizer", 1))
+	query = regexp.QuoteMeta("SELECT count(*) FROM `services` WHERE user_id = ? AND cost >= ? AND cost < ?")
+	s.mock.ExpectQuery(query).WithArgs(2, 0, 1000000).WillReturnRows(count())
+	s.mock.ExpectQuery(query).WithArgs(2, 1000000, 5000000).WillReturnRows(count())
+	s.mock.ExpectQuery(query).WithArgs(2, 5000000, 10000000).WillReturnRows(count())
+	query = regexp.QuoteMeta("SELECT count(*) FROM `services` WHERE user_id = ? AND cost >= ? AND")
+	s.mock.ExpectQuery(query).WithArgs(2, 10000000).WillReturnRows(count())
+	query = regexp.QuoteMeta("SELECT count(*) FROM `services` " +
+		"LEFT JOIN rating_aggregates ra ON ra.rated_type=? AND ra.rated_id=services.id AND ra.deleted_at IS NULL " +
+		"WHERE user_id = ? AND (ra.count > 0 AND ra.total >= ? * ra.count)")
+	for _, rating := range []float64{4, 3, 2, 1} {
+		s.mock.ExpectQuery(query).WithArgs("service", 2, rating).WillReturnRows(count())
+	}
+
+	facets := model.ServiceFacets{}
+	s.repository.GetFacets(&facets, &model.ServiceFilter{OrganizerID: 2})
+	s.Equal(int64(1), facets.Total)
+	s.Equal([]model.FacetCount{{Value: "venue", Label: "Venue", Count: 1}}, facets.Categories)
+	s.Len(facets.Prices, 4)
+	s.Equal("10000000-", facets.Prices[3].Value)
+	s.Len(facets.Ratings, 4)
 }
 
 func (s *serviceRepositorySuite) TestFind() {
 	query := regexp.QuoteMeta("SELECT * FROM `services`")
 	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 	s.mock.ExpectQuery(query).WithArgs("1").WillReturnRows(rows)
+	query = regexp.QuoteMeta("SELECT * FROM `service_addons` WHERE `service_addons`.`service_id` = ? AND `service_addons`.`deleted_at` IS NULL ORDER BY id")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	query = regexp.QuoteMeta("SELECT * FROM `service_images` WHERE `service_images`.`service_id` = ? AND `service_images`.`deleted_at` IS NULL ORDER BY position,id")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	query = regexp.QuoteMeta("SELECT * FROM `rating_aggregates` WHERE `rated_type` = ? AND `rating_aggregates`.`rated_id` = ? AND `rating_aggregates`.`deleted_at` IS NULL")
+	s.mock.ExpectQuery(query).WithArgs("service", 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	query = regexp.QuoteMeta("SELECT * FROM `service_tags` WHERE `service_tags`.`service_id` = ?")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"service_id", "tag_id"}))
+	query = regexp.QuoteMeta("SELECT * FROM `service_variants` WHERE `service_variants`.`service_id` = ? AND `service_variants`.`deleted_at` IS NULL ORDER BY price,id")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
 	s.repository.Find(&model.Service{}, "1")
 }
 
+func (s *serviceRepositorySuite) TestFindByExternalID() {
+	query := regexp.QuoteMeta("SELECT * FROM `services` WHERE (user_id = ? AND external_id = ?) AND `services`.`deleted_at` IS NULL")
+	s.mock.ExpectQuery(query).WithArgs(1, "SVC-1").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	s.repository.FindByExternalID(&model.Service{}, 1, "SVC-1")
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
 func (s *serviceRepositorySuite) TestCreate() {
 	query := regexp.QuoteMeta("INSERT INTO `services`")
 	s.mock.ExpectBegin()
@@ -57,7 +152,42 @@ func (s *serviceRepositorySuite) TestCreate() {
 }
 
 func (s *serviceRepositorySuite) TestUpdate() {
-	query := regexp.QuoteMeta("INSERT INTO `services`")
+	var query string
+	query = regexp.QuoteMeta("UPDATE `services`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	query = regexp.QuoteMeta("DELETE FROM `service_tags`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+	query = regexp.QuoteMeta("UPDATE `service_variants` SET `created_at`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	query = regexp.QuoteMeta("UPDATE `service_variants` SET `deleted_at`=? WHERE service_id = ? AND id NOT IN (?)")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(sqlmock.AnyArg(), 1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	query = regexp.QuoteMeta("INSERT INTO `service_addons`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(3, 1))
+	s.mock.ExpectCommit()
+	query = regexp.QuoteMeta("UPDATE `service_addons` SET `deleted_at`=? WHERE service_id = ? AND id NOT IN (?)")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(sqlmock.AnyArg(), 1, 3).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+	s.repository.Update(&model.Service{
+		Model:    gorm.Model{ID: 1},
+		Variants: []model.ServiceVariant{{Model: gorm.Model{ID: 2}, Name: "Gold"}},
+		Addons:   []model.ServiceAddon{{Name: "Extra hour", Price: 250000, MaxQuantity: 3}},
+	}, &request.UpdateServiceRequest{})
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	query = regexp.QuoteMeta("INSERT INTO `services`")
 	s.mock.ExpectBegin()
 	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
 	s.mock.ExpectCommit()
@@ -71,3 +201,31 @@ func (s *serviceRepositorySuite) TestDelete() {
 	s.mock.ExpectCommit()
 	s.repository.Delete(&model.Service{Model: gorm.Model{ID: 1}})
 }
+
+func (s *serviceRepositorySuite) TestUpdateStatus() {
+	query := regexp.QuoteMeta("UPDATE `services` SET `status`=?,`updated_at`=? WHERE `services`.`deleted_at` IS NULL AND `id` = ?")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(model.ServiceStatusArchived, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.repository.UpdateStatus(&model.Service{Model: gorm.Model{ID: 1}}, model.ServiceStatusArchived)
+}
+
+func (s *serviceRepositorySuite) TestCountOrders() {
+	query := regexp.QuoteMeta("SELECT count(*) FROM `order_services` WHERE service_id = ?")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	s.Equal(int64(2), s.repository.CountOrders(1))
+}
+
+func (s *serviceRepositorySuite) TestCountForCategory() {
+	query := regexp.QuoteMeta("SELECT count(*) FROM `services` WHERE category_id = ? AND `services`.`deleted_at` IS NULL")
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	s.Equal(int64(3), s.repository.CountForCategory(1))
+}
+
+func (s *serviceRepositorySuite) TestUnsetDeletedCategory() {
+	query := regexp.QuoteMeta("UPDATE `services` SET `category_id`=?,`updated_at`=? WHERE category_id = ? AND deleted_at IS NOT NULL")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(nil, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.NoError(s.repository.UnsetDeletedCategory(1))
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+)
+
+type ServiceRevisionRepository interface {
+	Get(revisions *[]model.ServiceRevision, serviceID uint)
+	FindLatest(revision *model.ServiceRevision, serviceID uint)
+	GetLatestIDs(serviceIDs []uint) map[uint]uint
+	Create(revision *model.ServiceRevision) error
+	WithTx(tx Tx) ServiceRevisionRepository
+}
+
+type serviceRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewServiceRevisionRepository(db *gorm.DB) ServiceRevisionRepository {
+	return &serviceRevisionRepository{db}
+}
+
+// Get lists the revisions of a service, oldest first.
+func (r *serviceRevisionRepository) Get(revisions *[]model.ServiceRevision, serviceID uint) {
+	r.db.Debug().Preload("User").Where("service_id = ?", serviceID).Order("number").Find(revisions)
+}
+
+func (r *serviceRevisionRepository) FindLatest(revision *model.ServiceRevision, serviceID uint) {
+	r.db.Debug().Where("service_id = ?", serviceID).Order("number DESC").Limit(1).Find(revision)
+}
+
+// GetLatestIDs maps each of serviceIDs that has revisions to the ID of its
+// latest one.
+func (r *serviceRevisionRepository) GetLatestIDs(serviceIDs []uint) map[uint]uint {
+	latest := []struct {
+		ServiceID uint
+		ID        uint
+	}{}
+	r.db.Debug().Model(&model.ServiceRevision{}).
+		Select("service_id, MAX(id) AS id").
+		Where("service_id IN ?", serviceIDs).
+		Group("service_id").
+		Scan(&latest)
+
+	ids := make(map[uint]uint)
+	for _, revision := range latest {
+		ids[revision.ServiceID] = revision.ID
+	}
+
+	return ids
+}
+
+func (r *serviceRevisionRepository) Create(revision *model.ServiceRevision) error {
+	return r.db.Debug().Omit("User").Create(revision).Error
+}
+
+func (r *serviceRevisionRepository) WithTx(tx Tx) ServiceRevisionRepository {
+	return &serviceRevisionRepository{tx.db}
+}
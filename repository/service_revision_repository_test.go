@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+)
+
+type serviceRevisionRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository ServiceRevisionRepository
+}
+
+func (s *serviceRevisionRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewServiceRevisionRepository(gorm)
+}
+
+func TestServiceRevisionRepositorySuite(t *testing.T) {
+	suite.Run(t, new(serviceRevisionRepositorySuite))
+}
+
+func (s *serviceRevisionRepositorySuite) TestGet() {
+	query := regexp.QuoteMeta("SELECT * FROM `service_revisions` WHERE service_id = ? AND `service_revisions`.`deleted_at` IS NULL ORDER BY number")
+	rows := sqlmock.NewRows([]string{"id", "user_id", "tags"}).AddRow(1, 2, `["wedding"]`)
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+	query = regexp.QuoteMeta("SELECT * FROM `users` WHERE `users`.`id` = ? AND `users`.`deleted_at` IS NULL")
+	s.mock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	revisions := []model.ServiceRevision{}
+	s.repository.Get(&revisions, 1)
+	s.Equal([]string{"wedding"}, revisions[0].Tags)
+}
+
+func (s *serviceRevisionRepositorySuite) TestFindLatest() {
+	query := regexp.QuoteMeta("SELECT * FROM `service_revisions` WHERE service_id = ? AND `service_revisions`.`deleted_at` IS NULL ORDER BY number DESC LIMIT 1")
+	rows := sqlmock.NewRows([]string{"id", "number"}).AddRow(4, 2)
+	s.mock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+	revision := model.ServiceRevision{}
+	s.repository.FindLatest(&revision, 1)
+	s.Equal(2, revision.Number)
+}
+
+func (s *serviceRevisionRepositorySuite) TestGetLatestIDs() {
+	query := regexp.QuoteMeta("SELECT service_id, MAX(id) AS id FROM `service_revisions` WHERE service_id IN (?,?) AND `service_revisions`.`deleted_at` IS NULL GROUP BY `service_id`")
+	rows := sqlmock.NewRows([]string{"service_id", "id"}).AddRow(1, 4)
+	s.mock.ExpectQuery(query).WithArgs(1, 2).WillReturnRows(rows)
+	s.Equal(map[uint]uint{1: 4}, s.repository.GetLatestIDs([]uint{1, 2}))
+}
+
+func (s *serviceRevisionRepositorySuite) TestCreate() {
+	query := regexp.QuoteMeta("INSERT INTO `service_revisions`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Create(&model.ServiceRevision{ServiceID: 1, Number: 1})
+	s.NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+)
+
+type TagRepository interface {
+	FirstOrCreate(tag *model.Tag, name string) error
+	WithTx(tx Tx) TagRepository
+}
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{db}
+}
+
+func (r *tagRepository) FirstOrCreate(tag *model.Tag, name string) error {
+	return r.db.Debug().Where(model.Tag{Name: name}).FirstOrCreate(tag).Error
+}
+
+func (r *tagRepository) WithTx(tx Tx) TagRepository {
+	return &tagRepository{tx.db}
+}
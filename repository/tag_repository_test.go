@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+)
+
+type tagRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository TagRepository
+}
+
+func (s *tagRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewTagRepository(gorm)
+}
+
+func TestTagRepositorySuite(t *testing.T) {
+	suite.Run(t, new(tagRepositorySuite))
+}
+
+func (s *tagRepositorySuite) TestFirstOrCreate() {
+	var query string
+	rows := sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "outdoor")
+	query = regexp.QuoteMeta("SELECT * FROM `tags` WHERE `tags`.`name` = ?")
+	s.mock.ExpectQuery(query).WithArgs("outdoor").WillReturnRows(rows)
+	tag := model.Tag{}
+	s.repository.FirstOrCreate(&tag, "outdoor")
+	s.Equal(uint(1), tag.ID)
+
+	query = regexp.QuoteMeta("SELECT * FROM `tags` WHERE `tags`.`name` = ?")
+	s.mock.ExpectQuery(query).WithArgs("indoor").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	query = regexp.QuoteMeta("INSERT INTO `tags`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(2, 1))
+	s.mock.ExpectCommit()
+	tag = model.Tag{}
+	s.repository.FirstOrCreate(&tag, "indoor")
+	s.Equal("indoor", tag.Name)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import "gorm.io/gorm"
+
+// Tx is a database transaction. Repositories are bound to it with their
+// WithTx method, so that what they write commits or rolls back together.
+type Tx struct {
+	db *gorm.DB
+}
+
+type Transactor interface {
+	// Transaction runs fn in a database transaction. It commits when fn
+	// returns nil and rolls back otherwise, returning fn's error.
+	Transaction(fn func(tx Tx) error) error
+}
+
+type transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) Transactor {
+	return &transactor{db}
+}
+
+func (t *transactor) Transaction(fn func(tx Tx) error) error {
+	return t.db.Debug().Transaction(func(db *gorm.DB) error {
+		return fn(Tx{db})
+	})
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+)
+
+type transactorSuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	transactor Transactor
+	repository PayoutRepository
+}
+
+func (s *transactorSuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.transactor = NewTransactor(gorm)
+	s.repository = NewPayoutRepository(gorm)
+}
+
+func TestTransactorSuite(t *testing.T) {
+	suite.Run(t, new(transactorSuite))
+}
+
+func (s *transactorSuite) TestTransaction() {
+	query := regexp.QuoteMeta("INSERT INTO `payouts`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	err := s.transactor.Transaction(func(tx Tx) error {
+		return s.repository.WithTx(tx).Create(&model.Payout{})
+	})
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *transactorSuite) TestTransactionRollback() {
+	query := regexp.QuoteMeta("INSERT INTO `payouts`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectRollback()
+	failure := errors.New("failure")
+	err := s.transactor.Transaction(func(tx Tx) error {
+		s.repository.WithTx(tx).Create(&model.Payout{})
+		return failure
+	})
+	s.ErrorIs(err, failure)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
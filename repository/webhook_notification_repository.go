@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookNotificationRepository interface {
+	Get(notifications *[]model.WebhookNotification, status string)
+	Find(notification *model.WebhookNotification, id string)
+	FindByKey(notification *model.WebhookNotification, transactionID, transactionStatus string)
+	Create(notification *model.WebhookNotification) (bool, error)
+	Claim(notification *model.WebhookNotification) bool
+	Save(notification *model.WebhookNotification)
+}
+
+type webhookNotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookNotificationRepository(db *gorm.DB) WebhookNotificationRepository {
+	return &webhookNotificationRepository{db}
+}
+
+func (r *webhookNotificationRepository) Get(notifications *[]model.WebhookNotification, status string) {
+	db := r.db.Debug()
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+
+	db.Order("id DESC").Find(notifications)
+}
+
+func (r *webhookNotificationRepository) Find(notification *model.WebhookNotification, id string) {
+	r.db.Debug().Where("id = ?", id).Find(notification)
+}
+
+func (r *webhookNotificationRepository) FindByKey(notification *model.WebhookNotification, transactionID, transactionStatus string) {
+	r.db.Debug().Where("transaction_id = ? AND transaction_status = ?", transactionID, transactionStatus).Find(notification)
+}
+
+// Create records a new notification. It reports false, without an error,
+// when the notification was already recorded under the same key.
+func (r *webhookNotificationRepository) Create(notification *model.WebhookNotification) (bool, error) {
+	result := r.db.Debug().Clauses(clause.OnConflict{DoNothing: true}).Create(notification)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Claim marks a failed notification as received again so that the caller
+// can process it once more. It reports false when the notification is not
+// failed anymore, such as when another caller claimed it first.
+func (r *webhookNotificationRepository) Claim(notification *model.WebhookNotification) bool {
+	result := r.db.Debug().Model(notification).
+		Where("status = ?", model.WebhookStatusFailed).
+		Update("status", model.WebhookStatusReceived)
+	if result.RowsAffected == 0 {
+		return false
+	}
+
+	notification.Status = model.WebhookStatusReceived
+	return true
+}
+
+func (r *webhookNotificationRepository) Save(notification *model.WebhookNotification) {
+	r.db.Debug().Save(notification)
+}
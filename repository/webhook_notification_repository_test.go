@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/testhelper"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type webhookNotificationRepositorySuite struct {
+	suite.Suite
+	mock       sqlmock.Sqlmock
+	repository WebhookNotificationRepository
+}
+
+func (s *webhookNotificationRepositorySuite) SetupSuite() {
+	var conn *sql.DB
+	conn, s.mock = testhelper.Mock()
+	gorm := testhelper.Init(conn)
+	s.repository = NewWebhookNotificationRepository(gorm)
+}
+
+func TestWebhookNotificationRepositorySuite(t *testing.T) {
+	suite.Run(t, new(webhookNotificationRepositorySuite))
+}
+
+func (s *webhookNotificationRepositorySuite) TestGet() {
+	var query string
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query = regexp.QuoteMeta("SELECT * FROM `webhook_notifications` WHERE `webhook_notifications`.`deleted_at` IS NULL ORDER BY id DESC")
+	s.mock.ExpectQuery(query).WillReturnRows(rows)
+	query = regexp.QuoteMeta("SELECT * FROM `webhook_notifications` WHERE status = ?")
+	s.mock.ExpectQuery(query).WithArgs(model.WebhookStatusFailed).WillReturnRows(rows)
+	s.repository.Get(&[]model.WebhookNotification{}, "")
+	s.repository.Get(&[]model.WebhookNotification{}, model.WebhookStatusFailed)
+}
+
+func (s *webhookNotificationRepositorySuite) TestFind() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `webhook_notifications` WHERE id = ?")
+	s.mock.ExpectQuery(query).WithArgs("1").WillReturnRows(rows)
+	s.repository.Find(&model.WebhookNotification{}, "1")
+}
+
+func (s *webhookNotificationRepositorySuite) TestFindByKey() {
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+	query := regexp.QuoteMeta("SELECT * FROM `webhook_notifications` WHERE (transaction_id = ? AND transaction_status = ?)")
+	s.mock.ExpectQuery(query).WithArgs("abc", "settlement").WillReturnRows(rows)
+	s.repository.FindByKey(&model.WebhookNotification{}, "abc", "settlement")
+}
+
+func (s *webhookNotificationRepositorySuite) TestCreate() {
+	query := regexp.QuoteMeta("INSERT INTO `webhook_notifications` (`created_at`,`updated_at`,`deleted_at`,`transaction_id`,`transaction_status`,`order_id`,`payment_type`,`status`,`error`,`attempts`,`processed_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	created, err := s.repository.Create(&model.WebhookNotification{TransactionID: "abc", TransactionStatus: "settlement"})
+	s.NoError(err)
+	s.True(created)
+
+	created, err = s.repository.Create(&model.WebhookNotification{TransactionID: "abc", TransactionStatus: "settlement"})
+	s.NoError(err)
+	s.False(created)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *webhookNotificationRepositorySuite) TestClaim() {
+	query := regexp.QuoteMeta("UPDATE `webhook_notifications` SET `status`=?,`updated_at`=? WHERE status = ? AND `webhook_notifications`.`deleted_at` IS NULL AND `id` = ?")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WithArgs(model.WebhookStatusReceived, sqlmock.AnyArg(), model.WebhookStatusFailed, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectCommit()
+
+	notification := model.WebhookNotification{Model: gorm.Model{ID: 1}, Status: model.WebhookStatusFailed}
+	s.True(s.repository.Claim(&notification))
+	s.Equal(model.WebhookStatusReceived, notification.Status)
+
+	notification = model.WebhookNotification{Model: gorm.Model{ID: 1}, Status: model.WebhookStatusFailed}
+	s.False(s.repository.Claim(&notification))
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *webhookNotificationRepositorySuite) TestSave() {
+	var query string
+	query = regexp.QuoteMeta("INSERT INTO `webhook_notifications`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectCommit()
+	s.repository.Save(&model.WebhookNotification{})
+
+	query = regexp.QuoteMeta("UPDATE `webhook_notifications`")
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+	s.repository.Save(&model.WebhookNotification{Model: gorm.Model{ID: 1}})
+}
@@ -13,7 +13,7 @@ type BasicBankAccount struct {
 
 func (b BasicBankAccount) Validate() error {
 	return validation.ValidateStruct(&b,
-		validation.Field(&b.Bank, validation.Required, validation.Match(regexp.MustCompile("^(bni|bri|bca)$"))),
+		validation.Field(&b.Bank, validation.Required, validation.Match(regexp.MustCompile("^(bni|bri|bca|permata|mandiri)$"))),
 		validation.Field(&b.VANumber, validation.Required, validation.Length(1, 50)),
 	)
 }
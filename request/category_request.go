@@ -0,0 +1,28 @@
+package request
+
+import (
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type BasicCategory struct {
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	ParentID *uint  `json:"parent_id"`
+}
+
+func (b BasicCategory) Validate() error {
+	return validation.ValidateStruct(&b,
+		validation.Field(&b.Name, validation.Required, validation.Length(1, 50)),
+		validation.Field(&b.Slug, validation.Required, validation.Length(1, 50), validation.Match(regexp.MustCompile("^[a-z0-9]+(-[a-z0-9]+)*$"))),
+	)
+}
+
+type CreateCategoryRequest struct {
+	BasicCategory
+}
+
+type UpdateCategoryRequest struct {
+	BasicCategory
+}
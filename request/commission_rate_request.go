@@ -0,0 +1,21 @@
+package request
+
+import (
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type SetCommissionRateRequest struct {
+	Scope   string  `json:"scope"`
+	ScopeID uint    `json:"scope_id"`
+	Rate    float64 `json:"rate"`
+}
+
+func (r SetCommissionRateRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Scope, validation.Required, validation.Match(regexp.MustCompile("^(organizer|category)$"))),
+		validation.Field(&r.ScopeID, validation.Required),
+		validation.Field(&r.Rate, validation.Min(float64(0)), validation.Max(float64(1))),
+	)
+}
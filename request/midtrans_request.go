@@ -1,6 +1,11 @@
 package request
 
 type MidtransTransactionNotificationRequest struct {
-	OrderID string `json:"order_id"`
-	Status  string `json:"transaction_status"`
+	TransactionID string `json:"transaction_id"`
+	OrderID       string `json:"order_id"`
+	Status        string `json:"transaction_status"`
+	PaymentType   string `json:"payment_type"`
+	StatusCode    string `json:"status_code"`
+	GrossAmount   string `json:"gross_amount"`
+	SignatureKey  string `json:"signature_key"`
 }
@@ -8,17 +8,28 @@ import (
 )
 
 type CreateOrderRequest struct {
-	DateOfEvent string `json:"date_of_event"`
-	FirstName   string `json:"first_name"`
-	LastName    string `json:"last_name"`
-	Phone       string `json:"phone"`
-	Email       string `json:"email"`
-	Address     string `json:"address"`
-	Note        string `json:"note"`
-	ServiceIDs  []uint `json:"service_ids"`
+	DateOfEvent   string `json:"date_of_event"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	Phone         string `json:"phone"`
+	Email         string `json:"email"`
+	Address       string `json:"address"`
+	Note          string `json:"note"`
+	PaymentMethod string `json:"payment_method"`
+	Bank          string `json:"bank"`
+	ServiceIDs    []uint `json:"service_ids"`
+
+	// Services selects services together with one of their variants and
+	// any add-ons. It can be used instead of, or alongside, ServiceIDs.
+	Services []OrderService `json:"services"`
 }
 
 func (r CreateOrderRequest) Validate() error {
+	serviceIDRules := []validation.Rule{}
+	if len(r.Services) == 0 {
+		serviceIDRules = append(serviceIDRules, validation.Required)
+	}
+
 	return validation.ValidateStruct(&r,
 		validation.Field(&r.DateOfEvent, validation.Required, validation.Match(regexp.MustCompile(`^\d{1,4}-\d{1,2}-\d{1,2}$`))),
 		validation.Field(&r.FirstName, validation.Required, validation.Length(1, 50)),
@@ -27,6 +38,44 @@ func (r CreateOrderRequest) Validate() error {
 		validation.Field(&r.Email, validation.Required, is.Email),
 		validation.Field(&r.Address, validation.Required, validation.Length(1, 300)),
 		validation.Field(&r.Note, validation.Required, validation.Length(1, 300)),
-		validation.Field(&r.ServiceIDs, validation.Required),
+		validation.Field(&r.PaymentMethod, validation.Match(regexp.MustCompile("^(bank_transfer|echannel|gopay|qris|manual)$"))),
+		validation.Field(&r.Bank, validation.Match(regexp.MustCompile("^(bni|bri|bca|permata)$"))),
+		validation.Field(&r.ServiceIDs, serviceIDRules...),
+		validation.Field(&r.Services),
+	)
+}
+
+type OrderService struct {
+	ServiceID uint         `json:"service_id"`
+	VariantID *uint        `json:"variant_id"`
+	Addons    []OrderAddon `json:"addons"`
+}
+
+func (s OrderService) Validate() error {
+	return validation.ValidateStruct(&s,
+		validation.Field(&s.ServiceID, validation.Required),
+		validation.Field(&s.Addons),
+	)
+}
+
+type OrderAddon struct {
+	AddonID  uint `json:"addon_id"`
+	Quantity int  `json:"quantity"`
+}
+
+func (a OrderAddon) Validate() error {
+	return validation.ValidateStruct(&a,
+		validation.Field(&a.AddonID, validation.Required),
+		validation.Field(&a.Quantity, validation.Required, validation.Min(1)),
+	)
+}
+
+type ReviewReceiptRequest struct {
+	Note string `json:"note"`
+}
+
+func (r ReviewReceiptRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Note, validation.Length(0, 300)),
 	)
 }
@@ -0,0 +1,34 @@
+package request
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+)
+
+type UpdateOrganizerProfileRequest struct {
+	DisplayName string            `json:"display_name"`
+	Bio         string            `json:"bio"`
+	City        string            `json:"city"`
+	LogoURL     string            `json:"logo_url"`
+	Phone       string            `json:"phone"`
+	Email       string            `json:"email"`
+	Website     string            `json:"website"`
+	SocialLinks map[string]string `json:"social_links"`
+	FoundedYear int               `json:"founded_year"`
+}
+
+func (r UpdateOrganizerProfileRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.DisplayName, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Bio, validation.Length(0, 1000)),
+		validation.Field(&r.City, validation.Length(0, 100)),
+		validation.Field(&r.LogoURL, validation.Length(0, 500), is.URL),
+		validation.Field(&r.Phone, validation.Length(0, 20)),
+		validation.Field(&r.Email, is.Email),
+		validation.Field(&r.Website, validation.Length(0, 255), is.URL),
+		validation.Field(&r.SocialLinks, validation.Length(0, 10), validation.Each(validation.Required, is.URL)),
+		validation.Field(&r.FoundedYear, validation.Min(1900), validation.Max(time.Now().Year())),
+	)
+}
@@ -0,0 +1,16 @@
+package request
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type CreatePayoutRequest struct {
+	Amount model.Money `json:"amount"`
+}
+
+func (r CreatePayoutRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Amount, validation.Required, validation.Min(model.Money(1))),
+	)
+}
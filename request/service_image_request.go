@@ -0,0 +1,24 @@
+package request
+
+import validation "github.com/go-ozzo/ozzo-validation"
+
+type UpdateServiceImageRequest struct {
+	Caption string `json:"caption"`
+	IsCover bool   `json:"is_cover"`
+}
+
+func (r UpdateServiceImageRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Caption, validation.Length(0, 200)),
+	)
+}
+
+type ReorderServiceImagesRequest struct {
+	ImageIDs []uint `json:"image_ids"`
+}
+
+func (r ReorderServiceImagesRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ImageIDs, validation.Required),
+	)
+}
@@ -0,0 +1,188 @@
+package request
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/andikabahari/eoplatform/model"
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type ImportServicesRequest struct {
+	DryRun bool `form:"dry_run"`
+}
+
+type ExportServicesRequest struct {
+	Format string `query:"format"`
+	Status string `query:"status"`
+}
+
+func (r ExportServicesRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Format, validation.In(ServiceImportFormatCSV, ServiceImportFormatJSON)),
+		validation.Field(&r.Status, validation.In(
+			model.ServiceStatusDraft,
+			model.ServiceStatusPublished,
+			model.ServiceStatusArchived,
+		)),
+	)
+}
+
+const (
+	ServiceImportFormatCSV  = "csv"
+	ServiceImportFormatJSON = "json"
+)
+
+// ServiceCSVColumns are the columns of a service CSV file, in the order
+// they are exported. Variants and add-ons can only be imported from JSON.
+var ServiceCSVColumns = []string{
+	"external_id",
+	"name",
+	"cost",
+	"phone",
+	"email",
+	"description",
+	"category_id",
+	"tags",
+	"status",
+}
+
+// ServiceCSVTagSeparator separates the tags within the tags column.
+const ServiceCSVTagSeparator = "|"
+
+var ErrUnsupportedImportFormat = errors.New("unsupported import format")
+
+// ImportServiceRow is one service of an import file, matched to the
+// organizer's existing services by ExternalID.
+type ImportServiceRow struct {
+	ExternalID string `json:"external_id"`
+	BasicService
+
+	// invalid holds the CSV cells that could not be read into their field.
+	invalid validation.Errors
+}
+
+func (r ImportServiceRow) Validate() error {
+	errs := validation.Errors{}
+	if err := r.BasicService.Validate(); err != nil {
+		fieldErrs, ok := err.(validation.Errors)
+		if !ok {
+			return err
+		}
+		for field, fieldErr := range fieldErrs {
+			errs[field] = fieldErr
+		}
+	}
+
+	errs["external_id"] = validation.Validate(r.ExternalID, validation.Required, validation.Length(1, 100))
+	for field, err := range r.invalid {
+		errs[field] = err
+	}
+
+	return errs.Filter()
+}
+
+// ParseServiceImport reads the rows of a CSV or JSON import file. Cells
+// that cannot be read are reported by the row's Validate rather than here,
+// so one bad row does not hide the others.
+func ParseServiceImport(r io.Reader, format string) ([]ImportServiceRow, error) {
+	switch format {
+	case ServiceImportFormatCSV:
+		return parseServiceCSV(r)
+	case ServiceImportFormatJSON:
+		rows := make([]ImportServiceRow, 0)
+		if err := json.NewDecoder(r).Decode(&rows); err != nil {
+			return nil, err
+		}
+		return rows, nil
+	}
+
+	return nil, ErrUnsupportedImportFormat
+}
+
+func parseServiceCSV(r io.Reader) ([]ImportServiceRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return []ImportServiceRow{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool)
+	for _, column := range ServiceCSVColumns {
+		known[column] = true
+	}
+
+	columns := make(map[string]int)
+	for i, column := range header {
+		// Spreadsheet programs often start the file with a byte order mark.
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if !known[column] {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+		columns[column] = i
+	}
+
+	rows := make([]ImportServiceRow, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		cell := func(column string) string {
+			if i, ok := columns[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row := ImportServiceRow{invalid: validation.Errors{}}
+		row.ExternalID = cell("external_id")
+		row.Name = cell("name")
+		row.Phone = cell("phone")
+		row.Email = cell("email")
+		row.Description = cell("description")
+		row.Status = cell("status")
+
+		if value := cell("cost"); value != "" {
+			cost, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				row.invalid["cost"] = errors.New("must be a whole number")
+			}
+			row.Cost = model.Money(cost)
+		}
+
+		if value := cell("category_id"); value != "" {
+			categoryID, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				row.invalid["category_id"] = errors.New("must be a category ID")
+			} else {
+				id := uint(categoryID)
+				row.CategoryID = &id
+			}
+		}
+
+		for _, tag := range strings.Split(cell("tags"), ServiceCSVTagSeparator) {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				row.Tags = append(row.Tags, tag)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,39 @@
+package request
+
+import (
+	"github.com/andikabahari/eoplatform/model"
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+type CreateServiceQuestionRequest struct {
+	Question string `json:"question"`
+}
+
+func (r CreateServiceQuestionRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Question, validation.Required, validation.Length(1, 1000)),
+	)
+}
+
+type AnswerServiceQuestionRequest struct {
+	Answer string `json:"answer"`
+}
+
+func (r AnswerServiceQuestionRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Answer, validation.Required, validation.Length(1, 2000)),
+	)
+}
+
+type ModerateServiceQuestionRequest struct {
+	Status string `json:"status"`
+}
+
+func (r ModerateServiceQuestionRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Status, validation.Required, validation.In(
+			model.QuestionStatusPublished,
+			model.QuestionStatusHidden,
+		)),
+	)
+}
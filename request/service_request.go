@@ -1,25 +1,75 @@
 package request
 
 import (
+	"github.com/andikabahari/eoplatform/model"
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 )
 
 type BasicService struct {
-	Name        string  `json:"name"`
-	Cost        float64 `json:"cost"`
-	Phone       string  `json:"phone"`
-	Email       string  `json:"email"`
-	Description string  `json:"description"`
+	Name        string      `json:"name"`
+	Cost        model.Money `json:"cost"`
+	Phone       string      `json:"phone"`
+	Email       string      `json:"email"`
+	Description string      `json:"description"`
+	CategoryID  *uint       `json:"category_id"`
+	Tags        []string    `json:"tags"`
+	Variants    []Variant   `json:"variants"`
+	Addons      []Addon     `json:"addons"`
+	Status      string      `json:"status"`
 }
 
 func (b BasicService) Validate() error {
 	return validation.ValidateStruct(&b,
 		validation.Field(&b.Name, validation.Required, validation.Length(1, 100)),
-		validation.Field(&b.Cost, validation.Required),
+		validation.Field(&b.Cost, validation.Required, validation.Min(model.Money(1))),
 		validation.Field(&b.Phone, validation.Required, validation.Length(1, 20)),
 		validation.Field(&b.Email, validation.Required, is.Email),
 		validation.Field(&b.Description, validation.Required, validation.Length(1, 500)),
+		validation.Field(&b.Tags, validation.Length(0, 10), validation.Each(validation.Length(1, 30))),
+		validation.Field(&b.Variants, validation.Length(0, 10)),
+		validation.Field(&b.Addons, validation.Length(0, 20)),
+		validation.Field(&b.Status, validation.In(
+			model.ServiceStatusDraft,
+			model.ServiceStatusPublished,
+			model.ServiceStatusArchived,
+		)),
+	)
+}
+
+// Variant is a package offered under a service. ID is only set when an
+// existing variant is being updated.
+type Variant struct {
+	ID          uint        `json:"id"`
+	Name        string      `json:"name"`
+	Price       model.Money `json:"price"`
+	Description string      `json:"description"`
+	Items       []string    `json:"items"`
+}
+
+func (v Variant) Validate() error {
+	return validation.ValidateStruct(&v,
+		validation.Field(&v.Name, validation.Required, validation.Length(1, 50)),
+		validation.Field(&v.Price, validation.Required, validation.Min(model.Money(1))),
+		validation.Field(&v.Description, validation.Length(0, 500)),
+		validation.Field(&v.Items, validation.Length(0, 20), validation.Each(validation.Length(1, 100))),
+	)
+}
+
+// Addon is an optional extra offered with a service. ID is only set when an
+// existing add-on is being updated.
+type Addon struct {
+	ID          uint        `json:"id"`
+	Name        string      `json:"name"`
+	Price       model.Money `json:"price"`
+	MaxQuantity int         `json:"max_quantity"`
+}
+
+func (a Addon) Validate() error {
+	return validation.ValidateStruct(&a,
+		validation.Field(&a.Name, validation.Required, validation.Length(1, 50)),
+		validation.Field(&a.Price, validation.Required, validation.Min(model.Money(1))),
+		validation.Field(&a.MaxQuantity, validation.Required, validation.Min(1), validation.Max(100)),
 	)
 }
 
@@ -30,3 +80,50 @@ type CreateServiceRequest struct {
 type UpdateServiceRequest struct {
 	BasicService
 }
+
+type GetServicesRequest struct {
+	Keyword     string      `query:"q"`
+	Category    string      `query:"category"`
+	Tag         string      `query:"tag"`
+	MinPrice    model.Money `query:"min_price"`
+	MaxPrice    model.Money `query:"max_price"`
+	Organizer   uint        `query:"organizer"`
+	MinRating   float64     `query:"min_rating"`
+	AvailableOn string      `query:"available_on"`
+	Sort        string      `query:"sort"`
+	Page        int         `query:"page"`
+	Limit       int         `query:"limit"`
+}
+
+func (r GetServicesRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.MinPrice, validation.Min(model.Money(0))),
+		validation.Field(&r.MaxPrice, validation.Min(model.Money(0))),
+		validation.Field(&r.MinRating, validation.Min(0.0), validation.Max(5.0)),
+		validation.Field(&r.AvailableOn, validation.Date("2006-01-02")),
+		validation.Field(&r.Sort, validation.In(
+			model.ServiceSortRelevance,
+			model.ServiceSortNewest,
+			model.ServiceSortPriceAsc,
+			model.ServiceSortPriceDesc,
+			model.ServiceSortRating,
+			model.ServiceSortPopular,
+		)),
+		validation.Field(&r.Page, validation.Min(0)),
+		validation.Field(&r.Limit, validation.Min(0), validation.Max(100)),
+	)
+}
+
+type GetOwnServicesRequest struct {
+	Status string `query:"status"`
+}
+
+func (r GetOwnServicesRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Status, validation.In(
+			model.ServiceStatusDraft,
+			model.ServiceStatusPublished,
+			model.ServiceStatusArchived,
+		)),
+	)
+}
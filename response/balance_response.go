@@ -0,0 +1,49 @@
+package response
+
+import (
+	"time"
+
+	"github.com/andikabahari/eoplatform/model"
+)
+
+type BalanceResponse struct {
+	Available model.Money `json:"available"`
+	Escrow    model.Money `json:"escrow"`
+	Payout    model.Money `json:"payout"`
+	Currency  string      `json:"currency"`
+}
+
+func NewBalanceResponse(balance model.Balance) *BalanceResponse {
+	res := BalanceResponse{}
+	res.Available = balance.Available
+	res.Escrow = balance.Escrow
+	res.Payout = balance.Payout
+	res.Currency = model.Currency
+
+	return &res
+}
+
+type LedgerEntryResponse struct {
+	ID        uint        `json:"id"`
+	CreatedAt time.Time   `json:"created_at"`
+	Kind      string      `json:"kind"`
+	Amount    model.Money `json:"amount"`
+	OrderID   *uint       `json:"order_id,omitempty"`
+	PayoutID  *uint       `json:"payout_id,omitempty"`
+}
+
+func NewLedgerEntriesResponse(entries []model.LedgerEntry) *[]LedgerEntryResponse {
+	res := make([]LedgerEntryResponse, 0)
+	for _, entry := range entries {
+		tmp := LedgerEntryResponse{}
+		tmp.ID = entry.ID
+		tmp.CreatedAt = entry.CreatedAt
+		tmp.Kind = entry.LedgerTransaction.Kind
+		tmp.Amount = entry.Amount
+		tmp.OrderID = entry.LedgerTransaction.OrderID
+		tmp.PayoutID = entry.LedgerTransaction.PayoutID
+		res = append(res, tmp)
+	}
+
+	return &res
+}
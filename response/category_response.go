@@ -0,0 +1,48 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type CategoryResponse struct {
+	ID       uint                `json:"id"`
+	Name     string              `json:"name"`
+	Slug     string              `json:"slug"`
+	ParentID *uint               `json:"parent_id,omitempty"`
+	Children *[]CategoryResponse `json:"children,omitempty"`
+}
+
+func NewCategoryResponse(category model.Category) *CategoryResponse {
+	res := CategoryResponse{}
+	res.ID = category.ID
+	res.Name = category.Name
+	res.Slug = category.Slug
+	res.ParentID = category.ParentID
+
+	return &res
+}
+
+// NewCategoriesResponse nests the flat list of categories into a tree.
+// Categories whose parent is missing from the list are treated as roots.
+func NewCategoriesResponse(categories []model.Category) *[]CategoryResponse {
+	known := make(map[uint]bool)
+	for _, category := range categories {
+		known[category.ID] = true
+	}
+
+	var children func(parentID *uint) *[]CategoryResponse
+	children = func(parentID *uint) *[]CategoryResponse {
+		res := make([]CategoryResponse, 0)
+		for _, category := range categories {
+			isRoot := category.ParentID == nil || !known[*category.ParentID]
+			if (parentID == nil && isRoot) || (parentID != nil && !isRoot && *category.ParentID == *parentID) {
+				tmp := NewCategoryResponse(category)
+				id := category.ID
+				tmp.Children = children(&id)
+				res = append(res, *tmp)
+			}
+		}
+
+		return &res
+	}
+
+	return children(nil)
+}
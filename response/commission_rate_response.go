@@ -0,0 +1,29 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type CommissionRateResponse struct {
+	ID      uint    `json:"id"`
+	Scope   string  `json:"scope"`
+	ScopeID uint    `json:"scope_id"`
+	Rate    float64 `json:"rate"`
+}
+
+func NewCommissionRateResponse(rate model.CommissionRate) *CommissionRateResponse {
+	res := CommissionRateResponse{}
+	res.ID = rate.ID
+	res.Scope = rate.Scope
+	res.ScopeID = rate.ScopeID
+	res.Rate = rate.Rate
+
+	return &res
+}
+
+func NewCommissionRatesResponse(rates []model.CommissionRate) *[]CommissionRateResponse {
+	res := make([]CommissionRateResponse, 0)
+	for _, rate := range rates {
+		res = append(res, *NewCommissionRateResponse(rate))
+	}
+
+	return &res
+}
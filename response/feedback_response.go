@@ -1,14 +1,28 @@
 package response
 
-import "github.com/andikabahari/eoplatform/model"
+import (
+	"time"
+
+	"github.com/andikabahari/eoplatform/model"
+)
 
 type FeedbackResponse struct {
-	ID          uint          `json:"id"`
-	Description string        `json:"description"`
-	Sentiment   string        `json:"sentiment"`
-	Rating      uint          `json:"rating"`
-	ToUser      *UserResponse `json:"to_user"`
-	FromUser    *UserResponse `json:"from_user"`
+	ID          uint                       `json:"id"`
+	Description string                     `json:"description"`
+	Sentiment   string                     `json:"sentiment"`
+	Rating      uint                       `json:"rating"`
+	Reply       string                     `json:"reply"`
+	RepliedAt   *time.Time                 `json:"replied_at"`
+	OrderID     *uint                      `json:"order_id"`
+	DateOfEvent string                     `json:"date_of_event,omitempty"`
+	Services    *[]FeedbackServiceResponse `json:"services"`
+	ToUser      *UserResponse              `json:"to_user"`
+	FromUser    *UserResponse              `json:"from_user"`
+}
+
+type FeedbackServiceResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
 }
 
 func NewFeedbackResponse(feedback model.Feedback) *FeedbackResponse {
@@ -16,11 +30,26 @@ func NewFeedbackResponse(feedback model.Feedback) *FeedbackResponse {
 	res.ID = feedback.ID
 	res.Description = feedback.Description
 	res.Rating = feedback.Rating
-	if feedback.Positive > 0 {
+	res.Reply = feedback.Reply
+	res.RepliedAt = feedback.RepliedAt
+	if feedback.SentimentStatus == model.SentimentStatusPending {
+		res.Sentiment = model.SentimentStatusPending
+	} else if feedback.Positive > 0 {
 		res.Sentiment = "positive"
 	} else if feedback.Negative > 0 {
 		res.Sentiment = "negative"
 	}
+	res.OrderID = feedback.OrderID
+	if feedback.Order.ID > 0 {
+		res.DateOfEvent = feedback.Order.DateOfEvent.Format("2006-01-02")
+	}
+
+	services := make([]FeedbackServiceResponse, 0)
+	for _, service := range feedback.Order.Services {
+		services = append(services, FeedbackServiceResponse{ID: service.ID, Name: service.Name})
+	}
+	res.Services = &services
+
 	res.ToUser = NewUserResponse(feedback.ToUser)
 	res.FromUser = NewUserResponse(feedback.FromUser)
 
@@ -0,0 +1,33 @@
+package response
+
+type MidtransVANumber struct {
+	Bank     string `json:"bank"`
+	VANumber string `json:"va_number"`
+}
+
+type MidtransAction struct {
+	Name   string `json:"name"`
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+type MidtransChargeResponse struct {
+	StatusCode        string             `json:"status_code"`
+	StatusMessage     string             `json:"status_message"`
+	TransactionID     string             `json:"transaction_id"`
+	TransactionStatus string             `json:"transaction_status"`
+	PaymentType       string             `json:"payment_type"`
+	VANumbers         []MidtransVANumber `json:"va_numbers"`
+	PermataVANumber   string             `json:"permata_va_number"`
+	BillKey           string             `json:"bill_key"`
+	BillerCode        string             `json:"biller_code"`
+	QRString          string             `json:"qr_string"`
+	Actions           []MidtransAction   `json:"actions"`
+	ExpiryTime        string             `json:"expiry_time"`
+}
+
+type MidtransSnapResponse struct {
+	Token         string   `json:"token"`
+	RedirectURL   string   `json:"redirect_url"`
+	ErrorMessages []string `json:"error_messages"`
+}
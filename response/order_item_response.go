@@ -0,0 +1,35 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type OrderItemResponse struct {
+	ServiceID         uint        `json:"service_id"`
+	ServiceRevisionID *uint       `json:"service_revision_id,omitempty"`
+	VariantID         *uint       `json:"variant_id,omitempty"`
+	AddonID           *uint       `json:"addon_id,omitempty"`
+	Kind              string      `json:"kind"`
+	Name              string      `json:"name"`
+	Price             model.Money `json:"price"`
+	Quantity          int         `json:"quantity"`
+	Total             model.Money `json:"total"`
+}
+
+func NewOrderItemsResponse(order model.Order) *[]OrderItemResponse {
+	res := make([]OrderItemResponse, 0)
+
+	for _, item := range order.Lines() {
+		tmp := OrderItemResponse{}
+		tmp.ServiceID = item.ServiceID
+		tmp.ServiceRevisionID = item.ServiceRevisionID
+		tmp.VariantID = item.VariantID
+		tmp.AddonID = item.AddonID
+		tmp.Kind = item.Kind
+		tmp.Name = item.Name
+		tmp.Price = item.Price
+		tmp.Quantity = item.Quantity
+		tmp.Total = item.Total()
+		res = append(res, tmp)
+	}
+
+	return &res
+}
@@ -7,21 +7,25 @@ import (
 )
 
 type OrderResponse struct {
-	ID            uint               `json:"id"`
-	CreatedAt     time.Time          `json:"created_at"`
-	DateOfEvent   string             `json:"date_of_event"`
-	TotalCost     float64            `json:"total_cost"`
-	PaymentStatus string             `json:"payment_status,omitempty"`
-	IsAccepted    bool               `json:"is_accepted"`
-	IsCompleted   bool               `json:"is_completed"`
-	FirstName     string             `json:"first_name"`
-	LastName      string             `json:"last_name"`
-	Phone         string             `json:"phone"`
-	Email         string             `json:"email"`
-	Address       string             `json:"address"`
-	Note          string             `json:"note"`
-	User          *UserResponse      `json:"user,omitempty"`
-	Services      *[]ServiceResponse `json:"services,omitempty"`
+	ID            uint                 `json:"id"`
+	CreatedAt     time.Time            `json:"created_at"`
+	DateOfEvent   string               `json:"date_of_event"`
+	TotalCost     model.Money          `json:"total_cost"`
+	Currency      string               `json:"currency"`
+	PaymentStatus string               `json:"payment_status,omitempty"`
+	PaymentMethod string               `json:"payment_method,omitempty"`
+	Payment       *PaymentResponse     `json:"payment,omitempty"`
+	IsAccepted    bool                 `json:"is_accepted"`
+	IsCompleted   bool                 `json:"is_completed"`
+	FirstName     string               `json:"first_name"`
+	LastName      string               `json:"last_name"`
+	Phone         string               `json:"phone"`
+	Email         string               `json:"email"`
+	Address       string               `json:"address"`
+	Note          string               `json:"note"`
+	User          *UserResponse        `json:"user,omitempty"`
+	Services      *[]ServiceResponse   `json:"services,omitempty"`
+	Items         *[]OrderItemResponse `json:"items"`
 }
 
 func NewOrderResponse(order model.Order) *OrderResponse {
@@ -29,6 +33,8 @@ func NewOrderResponse(order model.Order) *OrderResponse {
 	res.ID = order.ID
 	res.CreatedAt = order.CreatedAt
 	res.DateOfEvent = order.DateOfEvent.Format("2006-01-02")
+	res.TotalCost = order.TotalCost()
+	res.Currency = model.Currency
 	res.IsAccepted = order.IsAccepted
 	res.IsCompleted = order.IsCompleted
 	res.FirstName = order.FirstName
@@ -37,17 +43,17 @@ func NewOrderResponse(order model.Order) *OrderResponse {
 	res.Email = order.Email
 	res.Address = order.Address
 	res.Note = order.Note
+	res.PaymentMethod = order.PaymentMethod
 	res.User = NewUserResponse(order.User)
 
 	services := make([]ServiceResponse, 0)
 	for _, service := range order.Services {
-		res.TotalCost += service.Cost
-
 		tmp := ServiceResponse{}
 		tmp.ID = service.ID
 		tmp.Name = service.Name
 		tmp.Description = service.Description
 		tmp.Cost = service.Cost
+		tmp.Currency = model.Currency
 		tmp.Phone = service.Phone
 		tmp.Email = service.Email
 		tmp.User = nil
@@ -55,10 +61,21 @@ func NewOrderResponse(order model.Order) *OrderResponse {
 	}
 
 	res.Services = &services
+	res.Items = NewOrderItemsResponse(order)
 
 	return &res
 }
 
+func NewOrderWithPaymentResponse(order model.Order, payment model.Payment) *OrderResponse {
+	res := NewOrderResponse(order)
+	res.PaymentStatus = payment.Status
+	if payment.ID > 0 {
+		res.Payment = NewPaymentResponse(payment)
+	}
+
+	return res
+}
+
 func NewOrdersResponse(orders []model.Order) *[]OrderResponse {
 	res := make([]OrderResponse, 0)
 	for i, order := range orders {
@@ -66,6 +83,8 @@ func NewOrdersResponse(orders []model.Order) *[]OrderResponse {
 		tmp.ID = order.ID
 		tmp.CreatedAt = order.CreatedAt
 		tmp.DateOfEvent = order.DateOfEvent.Format("2006-01-02")
+		tmp.TotalCost = order.TotalCost()
+		tmp.Currency = model.Currency
 		tmp.IsAccepted = order.IsAccepted
 		tmp.IsCompleted = order.IsCompleted
 		tmp.FirstName = order.FirstName
@@ -74,28 +93,26 @@ func NewOrdersResponse(orders []model.Order) *[]OrderResponse {
 		tmp.Email = order.Email
 		tmp.Address = order.Address
 		tmp.Note = order.Note
+		tmp.PaymentMethod = order.PaymentMethod
 		tmp.User = nil
 		res = append(res, tmp)
 
-		var totalCost float64
-
 		services := make([]ServiceResponse, 0)
 		for _, service := range order.Services {
-			totalCost += service.Cost
-
 			tmp := ServiceResponse{}
 			tmp.ID = service.ID
 			tmp.Name = service.Name
 			tmp.Description = service.Description
 			tmp.Cost = service.Cost
+			tmp.Currency = model.Currency
 			tmp.Phone = service.Phone
 			tmp.Email = service.Email
 			tmp.User = nil
 			services = append(services, tmp)
 		}
 
-		res[i].TotalCost = totalCost
 		res[i].Services = &services
+		res[i].Items = NewOrderItemsResponse(order)
 	}
 
 	return &res
@@ -108,6 +125,8 @@ func NewOrdersWithPaymentStatusResponse(orders []model.Order, payments []model.P
 		tmp.ID = order.ID
 		tmp.CreatedAt = order.CreatedAt
 		tmp.DateOfEvent = order.DateOfEvent.Format("2006-01-02")
+		tmp.TotalCost = order.TotalCost()
+		tmp.Currency = model.Currency
 		tmp.IsAccepted = order.IsAccepted
 		tmp.IsCompleted = order.IsCompleted
 		tmp.FirstName = order.FirstName
@@ -116,29 +135,30 @@ func NewOrdersWithPaymentStatusResponse(orders []model.Order, payments []model.P
 		tmp.Email = order.Email
 		tmp.Address = order.Address
 		tmp.Note = order.Note
+		tmp.PaymentMethod = order.PaymentMethod
 		tmp.User = nil
 		tmp.PaymentStatus = payments[i].Status
+		if payments[i].ID > 0 {
+			tmp.Payment = NewPaymentResponse(payments[i])
+		}
 		res = append(res, tmp)
 
-		var totalCost float64
-
 		services := make([]ServiceResponse, 0)
 		for _, service := range order.Services {
-			totalCost += service.Cost
-
 			tmp := ServiceResponse{}
 			tmp.ID = service.ID
 			tmp.Name = service.Name
 			tmp.Description = service.Description
 			tmp.Cost = service.Cost
+			tmp.Currency = model.Currency
 			tmp.Phone = service.Phone
 			tmp.Email = service.Email
 			tmp.User = nil
 			services = append(services, tmp)
 		}
 
-		res[i].TotalCost = totalCost
 		res[i].Services = &services
+		res[i].Items = NewOrderItemsResponse(order)
 	}
 
 	return &res
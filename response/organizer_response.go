@@ -0,0 +1,74 @@
+package response
+
+import (
+	"time"
+
+	"github.com/andikabahari/eoplatform/model"
+)
+
+type OrganizerProfileResponse struct {
+	DisplayName string            `json:"display_name"`
+	Bio         string            `json:"bio"`
+	City        string            `json:"city"`
+	LogoURL     string            `json:"logo_url"`
+	Phone       string            `json:"phone"`
+	Email       string            `json:"email"`
+	Website     string            `json:"website"`
+	SocialLinks map[string]string `json:"social_links"`
+	FoundedYear int               `json:"founded_year,omitempty"`
+	YearsActive int               `json:"years_active"`
+}
+
+func NewOrganizerProfileResponse(profile model.OrganizerProfile) *OrganizerProfileResponse {
+	res := OrganizerProfileResponse{}
+	res.DisplayName = profile.DisplayName
+	res.Bio = profile.Bio
+	res.City = profile.City
+	res.LogoURL = profile.LogoURL
+	res.Phone = profile.Phone
+	res.Email = profile.Email
+	res.Website = profile.Website
+	res.SocialLinks = profile.SocialLinks
+	if res.SocialLinks == nil {
+		res.SocialLinks = make(map[string]string)
+	}
+	res.FoundedYear = profile.FoundedYear
+	if profile.FoundedYear > 0 {
+		res.YearsActive = time.Now().Year() - profile.FoundedYear
+	}
+
+	return &res
+}
+
+type OrganizerResponse struct {
+	ID              uint                      `json:"id"`
+	Name            string                    `json:"name"`
+	Username        string                    `json:"username"`
+	Profile         *OrganizerProfileResponse `json:"profile"`
+	Rating          *RatingResponse           `json:"rating"`
+	CompletedEvents int64                     `json:"completed_events"`
+	Services        *[]ServiceResponse        `json:"services"`
+}
+
+func NewOrganizerResponse(organizer model.Organizer) *OrganizerResponse {
+	res := OrganizerResponse{}
+	res.ID = organizer.User.ID
+	res.Name = organizer.User.Name
+	res.Username = organizer.User.Username
+	res.Profile = NewOrganizerProfileResponse(organizer.Profile)
+	if res.Profile.DisplayName == "" {
+		res.Profile.DisplayName = organizer.User.Name
+	}
+	res.Rating = NewRatingResponse(organizer.Rating)
+	res.CompletedEvents = organizer.CompletedEvents
+
+	services := make([]ServiceResponse, 0)
+	for _, service := range organizer.Services {
+		tmp := NewServiceResponse(service)
+		tmp.User = nil
+		services = append(services, *tmp)
+	}
+	res.Services = &services
+
+	return &res
+}
@@ -0,0 +1,20 @@
+package response
+
+type PaginationResponse struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int64 `json:"total_pages"`
+}
+
+func NewPaginationResponse(page, limit int, total int64) *PaginationResponse {
+	res := PaginationResponse{}
+	res.Page = page
+	res.Limit = limit
+	res.Total = total
+	if limit > 0 {
+		res.TotalPages = (total + int64(limit) - 1) / int64(limit)
+	}
+
+	return &res
+}
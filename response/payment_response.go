@@ -0,0 +1,46 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type PaymentResponse struct {
+	ID          uint        `json:"id"`
+	Amount      model.Money `json:"amount"`
+	Currency    string      `json:"currency"`
+	Status      string      `json:"status"`
+	Method      string      `json:"method"`
+	Bank        string      `json:"bank,omitempty"`
+	VANumber    string      `json:"va_number,omitempty"`
+	BillKey     string      `json:"bill_key,omitempty"`
+	BillerCode  string      `json:"biller_code,omitempty"`
+	QRString    string      `json:"qr_string,omitempty"`
+	QRURL       string      `json:"qr_url,omitempty"`
+	Deeplink    string      `json:"deeplink,omitempty"`
+	ExpiryTime  string      `json:"expiry_time,omitempty"`
+	SnapToken   string      `json:"snap_token,omitempty"`
+	RedirectURL string      `json:"redirect_url,omitempty"`
+	ReceiptURL  string      `json:"receipt_url,omitempty"`
+	ReceiptNote string      `json:"receipt_note,omitempty"`
+}
+
+func NewPaymentResponse(payment model.Payment) *PaymentResponse {
+	res := PaymentResponse{}
+	res.ID = payment.ID
+	res.Amount = payment.Amount
+	res.Currency = model.Currency
+	res.Status = payment.Status
+	res.Method = payment.Method
+	res.Bank = payment.Bank
+	res.VANumber = payment.VANumber
+	res.BillKey = payment.BillKey
+	res.BillerCode = payment.BillerCode
+	res.QRString = payment.QRString
+	res.QRURL = payment.QRURL
+	res.Deeplink = payment.Deeplink
+	res.ExpiryTime = payment.ExpiryTime
+	res.SnapToken = payment.SnapToken
+	res.RedirectURL = payment.RedirectURL
+	res.ReceiptURL = payment.ReceiptURL
+	res.ReceiptNote = payment.ReceiptNote
+
+	return &res
+}
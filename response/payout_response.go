@@ -0,0 +1,41 @@
+package response
+
+import (
+	"time"
+
+	"github.com/andikabahari/eoplatform/model"
+)
+
+type PayoutResponse struct {
+	ID          uint                 `json:"id"`
+	CreatedAt   time.Time            `json:"created_at"`
+	Amount      model.Money          `json:"amount"`
+	Status      string               `json:"status"`
+	BankAccount *BankAccountResponse `json:"bank_account,omitempty"`
+	User        *UserResponse        `json:"user,omitempty"`
+}
+
+func NewPayoutResponse(payout model.Payout) *PayoutResponse {
+	res := PayoutResponse{}
+	res.ID = payout.ID
+	res.CreatedAt = payout.CreatedAt
+	res.Amount = payout.Amount
+	res.Status = payout.Status
+	if payout.BankAccount.ID > 0 {
+		res.BankAccount = NewBankAccountResponse(payout.BankAccount)
+	}
+	if payout.User.ID > 0 {
+		res.User = NewUserResponse(payout.User)
+	}
+
+	return &res
+}
+
+func NewPayoutsResponse(payouts []model.Payout) *[]PayoutResponse {
+	res := make([]PayoutResponse, 0)
+	for _, payout := range payouts {
+		res = append(res, *NewPayoutResponse(payout))
+	}
+
+	return &res
+}
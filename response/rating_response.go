@@ -0,0 +1,28 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type RatingResponse struct {
+	Average       float64          `json:"average"`
+	Count         int64            `json:"count"`
+	Distribution  map[string]int64 `json:"distribution"`
+	PositiveShare float64          `json:"positive_share"`
+	NegativeShare float64          `json:"negative_share"`
+}
+
+func NewRatingResponse(aggregate model.RatingAggregate) *RatingResponse {
+	res := RatingResponse{}
+	res.Average = aggregate.Average()
+	res.Count = aggregate.Count
+	res.Distribution = map[string]int64{
+		"1": aggregate.Star1,
+		"2": aggregate.Star2,
+		"3": aggregate.Star3,
+		"4": aggregate.Star4,
+		"5": aggregate.Star5,
+	}
+	res.PositiveShare = aggregate.PositiveShare()
+	res.NegativeShare = aggregate.NegativeShare()
+
+	return &res
+}
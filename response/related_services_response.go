@@ -0,0 +1,16 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type RelatedServicesResponse struct {
+	BookedTogether []ServiceResponse `json:"booked_together"`
+	Similar        []ServiceResponse `json:"similar"`
+}
+
+func NewRelatedServicesResponse(related model.RelatedServices) *RelatedServicesResponse {
+	res := RelatedServicesResponse{}
+	res.BookedTogether = *NewServicesResponse(related.BookedTogether)
+	res.Similar = *NewServicesResponse(related.Similar)
+
+	return &res
+}
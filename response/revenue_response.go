@@ -0,0 +1,77 @@
+package response
+
+import (
+	"time"
+
+	"github.com/andikabahari/eoplatform/model"
+)
+
+type EarningResponse struct {
+	PaymentID  uint        `json:"payment_id"`
+	OrderID    uint        `json:"order_id"`
+	SettledAt  time.Time   `json:"settled_at"`
+	Gross      model.Money `json:"gross"`
+	Commission model.Money `json:"commission"`
+	Earning    model.Money `json:"earning"`
+}
+
+type EarningsResponse struct {
+	Gross      model.Money       `json:"gross"`
+	Commission model.Money       `json:"commission"`
+	Earning    model.Money       `json:"earning"`
+	Payments   []EarningResponse `json:"payments"`
+}
+
+func NewEarningsResponse(payments []model.Payment) *EarningsResponse {
+	res := EarningsResponse{}
+	res.Payments = make([]EarningResponse, 0)
+	for _, payment := range payments {
+		res.Gross += payment.Amount
+		res.Commission += payment.Commission
+		res.Earning += payment.Earning
+
+		tmp := EarningResponse{}
+		tmp.PaymentID = payment.ID
+		tmp.OrderID = payment.OrderID
+		tmp.SettledAt = payment.UpdatedAt
+		tmp.Gross = payment.Amount
+		tmp.Commission = payment.Commission
+		tmp.Earning = payment.Earning
+		res.Payments = append(res.Payments, tmp)
+	}
+
+	return &res
+}
+
+type OrganizerRevenueResponse struct {
+	User       *UserResponse `json:"user"`
+	Gross      model.Money   `json:"gross"`
+	Commission model.Money   `json:"commission"`
+	Earning    model.Money   `json:"earning"`
+}
+
+type RevenueResponse struct {
+	Gross      model.Money                `json:"gross"`
+	Commission model.Money                `json:"commission"`
+	Earning    model.Money                `json:"earning"`
+	Organizers []OrganizerRevenueResponse `json:"organizers"`
+}
+
+func NewRevenueResponse(revenues []model.Revenue) *RevenueResponse {
+	res := RevenueResponse{}
+	res.Organizers = make([]OrganizerRevenueResponse, 0)
+	for _, revenue := range revenues {
+		res.Gross += revenue.Gross
+		res.Commission += revenue.Commission
+		res.Earning += revenue.Earning
+
+		tmp := OrganizerRevenueResponse{}
+		tmp.User = NewUserResponse(revenue.User)
+		tmp.Gross = revenue.Gross
+		tmp.Commission = revenue.Commission
+		tmp.Earning = revenue.Earning
+		res.Organizers = append(res.Organizers, tmp)
+	}
+
+	return &res
+}
@@ -0,0 +1,32 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type ServiceAddonResponse struct {
+	ID          uint        `json:"id"`
+	Name        string      `json:"name"`
+	Price       model.Money `json:"price"`
+	Currency    string      `json:"currency"`
+	MaxQuantity int         `json:"max_quantity"`
+}
+
+func NewServiceAddonResponse(addon model.ServiceAddon) *ServiceAddonResponse {
+	res := ServiceAddonResponse{}
+	res.ID = addon.ID
+	res.Name = addon.Name
+	res.Price = addon.Price
+	res.Currency = model.Currency
+	res.MaxQuantity = addon.MaxQuantity
+
+	return &res
+}
+
+func NewServiceAddonsResponse(addons []model.ServiceAddon) *[]ServiceAddonResponse {
+	res := make([]ServiceAddonResponse, 0)
+
+	for _, addon := range addons {
+		res = append(res, *NewServiceAddonResponse(addon))
+	}
+
+	return &res
+}
@@ -0,0 +1,40 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type ServiceFacetsResponse struct {
+	Categories *[]FacetCountResponse `json:"categories"`
+	Organizers *[]FacetCountResponse `json:"organizers"`
+	Prices     *[]FacetCountResponse `json:"prices"`
+	Ratings    *[]FacetCountResponse `json:"ratings"`
+}
+
+type FacetCountResponse struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int64  `json:"count"`
+}
+
+func NewServiceFacetsResponse(facets model.ServiceFacets) *ServiceFacetsResponse {
+	res := ServiceFacetsResponse{}
+	res.Categories = newFacetCountsResponse(facets.Categories)
+	res.Organizers = newFacetCountsResponse(facets.Organizers)
+	res.Prices = newFacetCountsResponse(facets.Prices)
+	res.Ratings = newFacetCountsResponse(facets.Ratings)
+
+	return &res
+}
+
+func newFacetCountsResponse(counts []model.FacetCount) *[]FacetCountResponse {
+	res := make([]FacetCountResponse, 0)
+
+	for _, count := range counts {
+		tmp := FacetCountResponse{}
+		tmp.Value = count.Value
+		tmp.Label = count.Label
+		tmp.Count = count.Count
+		res = append(res, tmp)
+	}
+
+	return &res
+}
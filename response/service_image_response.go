@@ -0,0 +1,34 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type ServiceImageResponse struct {
+	ID           uint   `json:"id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	Caption      string `json:"caption"`
+	Position     int    `json:"position"`
+	IsCover      bool   `json:"is_cover"`
+}
+
+func NewServiceImageResponse(image model.ServiceImage) *ServiceImageResponse {
+	res := ServiceImageResponse{}
+	res.ID = image.ID
+	res.URL = image.URL
+	res.ThumbnailURL = image.ThumbnailURL
+	res.Caption = image.Caption
+	res.Position = image.Position
+	res.IsCover = image.IsCover
+
+	return &res
+}
+
+func NewServiceImagesResponse(images []model.ServiceImage) *[]ServiceImageResponse {
+	res := make([]ServiceImageResponse, 0)
+
+	for _, image := range images {
+		res = append(res, *NewServiceImageResponse(image))
+	}
+
+	return &res
+}
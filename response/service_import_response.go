@@ -0,0 +1,139 @@
+package response
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/request"
+)
+
+type ServiceImportResponse struct {
+	DryRun  bool                       `json:"dry_run"`
+	Created int                        `json:"created"`
+	Updated int                        `json:"updated"`
+	Rows    []ServiceImportRowResponse `json:"rows"`
+}
+
+type ServiceImportRowResponse struct {
+	Row        int               `json:"row"`
+	ExternalID string            `json:"external_id"`
+	Action     string            `json:"action"`
+	ServiceID  uint              `json:"service_id,omitempty"`
+	Errors     map[string]string `json:"errors,omitempty"`
+}
+
+func NewServiceImportResponse(result model.ServiceImport) *ServiceImportResponse {
+	res := ServiceImportResponse{}
+	res.DryRun = result.DryRun
+	res.Created = result.Created
+	res.Updated = result.Updated
+	res.Rows = make([]ServiceImportRowResponse, 0)
+	for _, row := range result.Rows {
+		tmp := ServiceImportRowResponse{}
+		tmp.Row = row.Row
+		tmp.ExternalID = row.ExternalID
+		tmp.Action = row.Action
+		tmp.ServiceID = row.ServiceID
+		tmp.Errors = row.Errors
+		res.Rows = append(res.Rows, tmp)
+	}
+
+	return &res
+}
+
+// NewServiceImportErrorsResponse keys the errors of the invalid rows by row
+// number, the way validation errors are keyed by field.
+func NewServiceImportErrorsResponse(result model.ServiceImport) map[string]map[string]string {
+	res := make(map[string]map[string]string)
+	for _, row := range result.Rows {
+		if len(row.Errors) > 0 {
+			res[fmt.Sprint(row.Row)] = row.Errors
+		}
+	}
+
+	return res
+}
+
+// NewServiceExportResponse lists services in the shape they are imported
+// in, so an export can be edited and imported again.
+func NewServiceExportResponse(services []model.Service) *[]request.ImportServiceRow {
+	res := make([]request.ImportServiceRow, 0)
+
+	for _, service := range services {
+		tmp := request.ImportServiceRow{}
+		tmp.ExternalID = service.ExportID()
+		tmp.Name = service.Name
+		tmp.Cost = service.Cost
+		tmp.Phone = service.Phone
+		tmp.Email = service.Email
+		tmp.Description = service.Description
+		tmp.CategoryID = service.CategoryID
+		tmp.Tags = newTagNames(service.Tags)
+		tmp.Status = service.Status
+
+		tmp.Variants = make([]request.Variant, 0)
+		for _, variant := range service.Variants {
+			tmp.Variants = append(tmp.Variants, request.Variant{
+				ID:          variant.ID,
+				Name:        variant.Name,
+				Price:       variant.Price,
+				Description: variant.Description,
+				Items:       variant.Items,
+			})
+		}
+
+		tmp.Addons = make([]request.Addon, 0)
+		for _, addon := range service.Addons {
+			tmp.Addons = append(tmp.Addons, request.Addon{
+				ID:          addon.ID,
+				Name:        addon.Name,
+				Price:       addon.Price,
+				MaxQuantity: addon.MaxQuantity,
+			})
+		}
+
+		res = append(res, tmp)
+	}
+
+	return &res
+}
+
+// NewServiceExportCSV writes services with the columns of
+// request.ServiceCSVColumns. Variants and add-ons are left out.
+func NewServiceExportCSV(services []model.Service) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	writer := csv.NewWriter(buf)
+
+	if err := writer.Write(request.ServiceCSVColumns); err != nil {
+		return nil, err
+	}
+
+	for _, row := range *NewServiceExportResponse(services) {
+		categoryID := ""
+		if row.CategoryID != nil {
+			categoryID = fmt.Sprint(*row.CategoryID)
+		}
+
+		record := []string{
+			row.ExternalID,
+			row.Name,
+			fmt.Sprint(row.Cost),
+			row.Phone,
+			row.Email,
+			row.Description,
+			categoryID,
+			strings.Join(row.Tags, request.ServiceCSVTagSeparator),
+			row.Status,
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+
+	return buf.Bytes(), writer.Error()
+}
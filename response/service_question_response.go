@@ -0,0 +1,41 @@
+package response
+
+import (
+	"time"
+
+	"github.com/andikabahari/eoplatform/model"
+)
+
+type ServiceQuestionResponse struct {
+	ID         uint          `json:"id"`
+	ServiceID  uint          `json:"service_id"`
+	Question   string        `json:"question"`
+	Answer     string        `json:"answer"`
+	AnsweredAt *time.Time    `json:"answered_at"`
+	Status     string        `json:"status"`
+	CreatedAt  time.Time     `json:"created_at"`
+	User       *UserResponse `json:"user"`
+}
+
+func NewServiceQuestionResponse(question model.ServiceQuestion) *ServiceQuestionResponse {
+	res := ServiceQuestionResponse{}
+	res.ID = question.ID
+	res.ServiceID = question.ServiceID
+	res.Question = question.Question
+	res.Answer = question.Answer
+	res.AnsweredAt = question.AnsweredAt
+	res.Status = question.Status
+	res.CreatedAt = question.CreatedAt
+	res.User = NewUserResponse(question.User)
+
+	return &res
+}
+
+func NewServiceQuestionsResponse(questions []model.ServiceQuestion) *[]ServiceQuestionResponse {
+	res := make([]ServiceQuestionResponse, 0)
+	for _, question := range questions {
+		res = append(res, *NewServiceQuestionResponse(question))
+	}
+
+	return &res
+}
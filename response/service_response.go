@@ -3,13 +3,23 @@ package response
 import "github.com/andikabahari/eoplatform/model"
 
 type ServiceResponse struct {
-	ID          uint          `json:"id"`
-	Name        string        `json:"name"`
-	Cost        float64       `json:"cost"`
-	Phone       string        `json:"phone"`
-	Email       string        `json:"email"`
-	Description string        `json:"description"`
-	User        *UserResponse `json:"user,omitempty"`
+	ID            uint                      `json:"id"`
+	Name          string                    `json:"name"`
+	Cost          model.Money               `json:"cost"`
+	Currency      string                    `json:"currency"`
+	Phone         string                    `json:"phone"`
+	Email         string                    `json:"email"`
+	Description   string                    `json:"description"`
+	Status        string                    `json:"status"`
+	Category      *CategoryResponse         `json:"category,omitempty"`
+	Tags          []string                  `json:"tags,omitempty"`
+	Images        *[]ServiceImageResponse   `json:"images"`
+	Variants      *[]ServiceVariantResponse `json:"variants"`
+	Addons        *[]ServiceAddonResponse   `json:"addons"`
+	Rating        *RatingResponse           `json:"rating"`
+	FavoriteCount *int64                    `json:"favorite_count,omitempty"`
+	Highlights    map[string]string         `json:"highlights,omitempty"`
+	User          *UserResponse             `json:"user,omitempty"`
 }
 
 func NewServiceResponse(service model.Service) *ServiceResponse {
@@ -17,9 +27,21 @@ func NewServiceResponse(service model.Service) *ServiceResponse {
 	res.ID = service.ID
 	res.Name = service.Name
 	res.Cost = service.Cost
+	res.Currency = model.Currency
 	res.Phone = service.Phone
 	res.Email = service.Email
 	res.Description = service.Description
+	res.Status = service.Status
+	if service.Category.ID > 0 {
+		res.Category = NewCategoryResponse(service.Category)
+	}
+	res.Tags = newTagNames(service.Tags)
+	res.Images = NewServiceImagesResponse(service.Images)
+	res.Variants = NewServiceVariantsResponse(service.Variants)
+	res.Addons = NewServiceAddonsResponse(service.Addons)
+	res.Rating = NewRatingResponse(service.Rating)
+	res.FavoriteCount = service.FavoriteCount
+	res.Highlights = service.Highlights
 	if service.User.ID > 0 {
 		res.User = NewUserResponse(service.User)
 	}
@@ -35,12 +57,33 @@ func NewServicesResponse(services []model.Service) *[]ServiceResponse {
 		tmp.ID = service.ID
 		tmp.Name = service.Name
 		tmp.Cost = service.Cost
+		tmp.Currency = model.Currency
 		tmp.Phone = service.Phone
 		tmp.Email = service.Email
 		tmp.Description = service.Description
+		tmp.Status = service.Status
+		if service.Category.ID > 0 {
+			tmp.Category = NewCategoryResponse(service.Category)
+		}
+		tmp.Tags = newTagNames(service.Tags)
+		tmp.Images = NewServiceImagesResponse(service.Images)
+		tmp.Variants = NewServiceVariantsResponse(service.Variants)
+		tmp.Addons = NewServiceAddonsResponse(service.Addons)
+		tmp.Rating = NewRatingResponse(service.Rating)
+		tmp.FavoriteCount = service.FavoriteCount
+		tmp.Highlights = service.Highlights
 		tmp.User = NewUserResponse(service.User)
 		res = append(res, tmp)
 	}
 
 	return &res
 }
+
+func newTagNames(tags []model.Tag) []string {
+	names := make([]string, 0)
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+
+	return names
+}
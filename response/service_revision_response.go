@@ -0,0 +1,108 @@
+package response
+
+import (
+	"time"
+
+	"github.com/andikabahari/eoplatform/model"
+	"gorm.io/gorm"
+)
+
+type ServiceRevisionResponse struct {
+	ID          uint                      `json:"id"`
+	Number      int                       `json:"number"`
+	Name        string                    `json:"name"`
+	Cost        model.Money               `json:"cost"`
+	Currency    string                    `json:"currency"`
+	Phone       string                    `json:"phone"`
+	Email       string                    `json:"email"`
+	Description string                    `json:"description"`
+	Status      string                    `json:"status"`
+	CategoryID  *uint                     `json:"category_id"`
+	Tags        []string                  `json:"tags"`
+	Variants    *[]ServiceVariantResponse `json:"variants"`
+	Addons      *[]ServiceAddonResponse   `json:"addons"`
+	Changes     *[]RevisionChangeResponse `json:"changes"`
+	User        *UserResponse             `json:"user"`
+	CreatedAt   time.Time                 `json:"created_at"`
+}
+
+type RevisionChangeResponse struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// NewServiceRevisionsResponse takes the revisions oldest first and lists
+// them newest first, each with what it changed from the one before.
+func NewServiceRevisionsResponse(revisions []model.ServiceRevision) *[]ServiceRevisionResponse {
+	res := make([]ServiceRevisionResponse, 0)
+
+	for i := len(revisions) - 1; i >= 0; i-- {
+		revision := revisions[i]
+
+		var previous *model.ServiceRevision
+		if i > 0 {
+			previous = &revisions[i-1]
+		}
+
+		changes := make([]RevisionChangeResponse, 0)
+		for _, change := range revision.Changes(previous) {
+			changes = append(changes, RevisionChangeResponse{change.Field, change.From, change.To})
+		}
+
+		tmp := ServiceRevisionResponse{}
+		tmp.ID = revision.ID
+		tmp.Number = revision.Number
+		tmp.Name = revision.Name
+		tmp.Cost = revision.Cost
+		tmp.Currency = model.Currency
+		tmp.Phone = revision.Phone
+		tmp.Email = revision.Email
+		tmp.Description = revision.Description
+		tmp.Status = revision.Status
+		tmp.CategoryID = revision.CategoryID
+		tmp.Tags = revision.Tags
+		if tmp.Tags == nil {
+			tmp.Tags = make([]string, 0)
+		}
+		tmp.Variants = newRevisionVariantsResponse(revision.Variants)
+		tmp.Addons = newRevisionAddonsResponse(revision.Addons)
+		tmp.Changes = &changes
+		tmp.User = NewUserResponse(revision.User)
+		tmp.CreatedAt = revision.CreatedAt
+		res = append(res, tmp)
+	}
+
+	return &res
+}
+
+func newRevisionVariantsResponse(variants []model.RevisionVariant) *[]ServiceVariantResponse {
+	res := make([]ServiceVariantResponse, 0)
+
+	for _, variant := range variants {
+		res = append(res, *NewServiceVariantResponse(model.ServiceVariant{
+			Model:       gorm.Model{ID: variant.ID},
+			Name:        variant.Name,
+			Price:       variant.Price,
+			Description: variant.Description,
+			Items:       variant.Items,
+		}))
+	}
+
+	return &res
+}
+
+func newRevisionAddonsResponse(addons []model.RevisionAddon) *[]ServiceAddonResponse {
+	res := make([]ServiceAddonResponse, 0)
+
+	for _, addon := range addons {
+		res = append(res, *NewServiceAddonResponse(model.ServiceAddon{
+			Model:       gorm.Model{ID: addon.ID},
+			Name:        addon.Name,
+			Price:       addon.Price,
+			MaxQuantity: addon.MaxQuantity,
+		}))
+	}
+
+	return &res
+}
@@ -0,0 +1,37 @@
+package response
+
+import "github.com/andikabahari/eoplatform/model"
+
+type ServiceVariantResponse struct {
+	ID          uint        `json:"id"`
+	Name        string      `json:"name"`
+	Price       model.Money `json:"price"`
+	Currency    string      `json:"currency"`
+	Description string      `json:"description"`
+	Items       []string    `json:"items"`
+}
+
+func NewServiceVariantResponse(variant model.ServiceVariant) *ServiceVariantResponse {
+	res := ServiceVariantResponse{}
+	res.ID = variant.ID
+	res.Name = variant.Name
+	res.Price = variant.Price
+	res.Currency = model.Currency
+	res.Description = variant.Description
+	res.Items = variant.Items
+	if res.Items == nil {
+		res.Items = make([]string, 0)
+	}
+
+	return &res
+}
+
+func NewServiceVariantsResponse(variants []model.ServiceVariant) *[]ServiceVariantResponse {
+	res := make([]ServiceVariantResponse, 0)
+
+	for _, variant := range variants {
+		res = append(res, *NewServiceVariantResponse(variant))
+	}
+
+	return &res
+}
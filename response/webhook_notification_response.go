@@ -0,0 +1,45 @@
+package response
+
+import (
+	"time"
+
+	"github.com/andikabahari/eoplatform/model"
+)
+
+type WebhookNotificationResponse struct {
+	ID                uint       `json:"id"`
+	CreatedAt         time.Time  `json:"created_at"`
+	TransactionID     string     `json:"transaction_id"`
+	TransactionStatus string     `json:"transaction_status"`
+	OrderID           string     `json:"order_id"`
+	PaymentType       string     `json:"payment_type,omitempty"`
+	Status            string     `json:"status"`
+	Error             string     `json:"error,omitempty"`
+	Attempts          int        `json:"attempts"`
+	ProcessedAt       *time.Time `json:"processed_at,omitempty"`
+}
+
+func NewWebhookNotificationResponse(notification model.WebhookNotification) *WebhookNotificationResponse {
+	res := WebhookNotificationResponse{}
+	res.ID = notification.ID
+	res.CreatedAt = notification.CreatedAt
+	res.TransactionID = notification.TransactionID
+	res.TransactionStatus = notification.TransactionStatus
+	res.OrderID = notification.OrderID
+	res.PaymentType = notification.PaymentType
+	res.Status = notification.Status
+	res.Error = notification.Error
+	res.Attempts = notification.Attempts
+	res.ProcessedAt = notification.ProcessedAt
+
+	return &res
+}
+
+func NewWebhookNotificationsResponse(notifications []model.WebhookNotification) *[]WebhookNotificationResponse {
+	res := make([]WebhookNotificationResponse, 0)
+	for _, notification := range notifications {
+		res = append(res, *NewWebhookNotificationResponse(notification))
+	}
+
+	return &res
+}
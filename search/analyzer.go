@@ -0,0 +1,262 @@
+package search
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type token struct {
+	Text  string
+	Start int
+	End   int
+}
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "for": true, "in": true, "of": true,
+	"on": true, "or": true, "the": true, "to": true, "with": true,
+	"dan": true, "dari": true, "di": true, "ini": true, "itu": true, "ke": true,
+	"untuk": true, "yang": true, "atau": true, "dengan": true,
+}
+
+// tokenize splits text into lowercase words made of letters and digits,
+// keeping the byte offsets of each word in the original text.
+func tokenize(text string) []token {
+	tokens := make([]token, 0)
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, token{strings.ToLower(text[start:i]), start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{strings.ToLower(text[start:]), start, len(text)})
+	}
+
+	return tokens
+}
+
+// Analyze turns text into the terms stored in and looked up from the index.
+func Analyze(text string) []string {
+	terms := make([]string, 0)
+	for _, token := range tokenize(text) {
+		if term := analyzeWord(token.Text); term != "" {
+			terms = append(terms, term)
+		}
+	}
+
+	return terms
+}
+
+// TopWords returns up to n words of text, those whose terms occur most
+// often first. Each term is given as the first word it was found in, so the
+// words can be searched for again.
+func TopWords(text string, n int) []string {
+	counts := make(map[string]int)
+	words := make(map[string]string)
+	terms := make([]string, 0)
+	for _, token := range tokenize(text) {
+		term := analyzeWord(token.Text)
+		if term == "" {
+			continue
+		}
+		if counts[term] == 0 {
+			words[term] = token.Text
+			terms = append(terms, term)
+		}
+		counts[term]++
+	}
+
+	sort.SliceStable(terms, func(a, b int) bool {
+		return counts[terms[a]] > counts[terms[b]]
+	})
+	if len(terms) > n {
+		terms = terms[:n]
+	}
+
+	top := make([]string, 0, len(terms))
+	for _, term := range terms {
+		top = append(top, words[term])
+	}
+
+	return top
+}
+
+func analyzeWord(word string) string {
+	if stopWords[word] {
+		return ""
+	}
+
+	return Stem(word)
+}
+
+// Stem reduces an Indonesian or English word to its root. Catalog text
+// mixes both languages, so Indonesian affixes are tried first and English
+// suffixes only when no Indonesian affix was found.
+func Stem(word string) string {
+	if utf8.RuneCountInString(word) <= 3 {
+		return word
+	}
+
+	if stem := stemIndonesian(word); stem != word {
+		return stem
+	}
+
+	return stemEnglish(word)
+}
+
+const minStemLength = 3
+
+func cut(word, suffix string) (string, bool) {
+	if strings.HasSuffix(word, suffix) && len(word)-len(suffix) >= minStemLength {
+		return strings.TrimSuffix(word, suffix), true
+	}
+
+	return word, false
+}
+
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiou", b) >= 0
+}
+
+// stemIndonesian is a dictionary-free take on the Nazief-Adriani rules:
+// it strips particles, possessive pronouns, derivational suffixes and then
+// up to two derivational prefixes.
+func stemIndonesian(word string) string {
+	for _, suffix := range []string{"lah", "kah", "tah", "pun"} {
+		if stem, ok := cut(word, suffix); ok {
+			word = stem
+			break
+		}
+	}
+
+	for _, suffix := range []string{"nya", "ku", "mu"} {
+		if stem, ok := cut(word, suffix); ok {
+			word = stem
+			break
+		}
+	}
+
+	for _, suffix := range []string{"kan", "an"} {
+		if stem, ok := cut(word, suffix); ok && hasIndonesianPrefix(word) {
+			word = stem
+			break
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		stem := stripIndonesianPrefix(word)
+		if stem == word {
+			break
+		}
+		word = stem
+	}
+
+	return word
+}
+
+func hasIndonesianPrefix(word string) bool {
+	return stripIndonesianPrefix(word) != word
+}
+
+func stripIndonesianPrefix(word string) string {
+	rest := func(prefix string) (string, bool) {
+		if strings.HasPrefix(word, prefix) && len(word)-len(prefix) >= minStemLength {
+			return word[len(prefix):], true
+		}
+		return "", false
+	}
+
+	if stem, ok := rest("meng"); ok {
+		return stem
+	}
+	if stem, ok := rest("peng"); ok {
+		return stem
+	}
+	if stem, ok := rest("meny"); ok && isVowel(stem[0]) {
+		return "s" + stem
+	}
+	if stem, ok := rest("peny"); ok && isVowel(stem[0]) {
+		return "s" + stem
+	}
+	for _, prefix := range []string{"mem", "pem"} {
+		if stem, ok := rest(prefix); ok {
+			if isVowel(stem[0]) {
+				return "p" + stem
+			}
+			return stem
+		}
+	}
+	for _, prefix := range []string{"men", "pen"} {
+		if stem, ok := rest(prefix); ok {
+			if isVowel(stem[0]) {
+				return "t" + stem
+			}
+			return stem
+		}
+	}
+	for _, prefix := range []string{"ber", "ter", "per"} {
+		if stem, ok := rest(prefix); ok {
+			return stem
+		}
+	}
+	for _, prefix := range []string{"me", "pe"} {
+		if stem, ok := rest(prefix); ok && strings.IndexByte("lmnrwy", stem[0]) >= 0 {
+			return stem
+		}
+	}
+	for _, prefix := range []string{"di", "ke", "se"} {
+		if stem, ok := rest(prefix); ok {
+			return stem
+		}
+	}
+
+	return word
+}
+
+// stemEnglish strips common inflectional and derivational suffixes, in the
+// spirit of the first steps of the Porter stemmer.
+func stemEnglish(word string) string {
+	switch {
+	case strings.HasSuffix(word, "sses"):
+		word = strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "ies"):
+		if stem, ok := cut(word, "ies"); ok {
+			word = stem + "y"
+		}
+	case strings.HasSuffix(word, "s") &&
+		!strings.HasSuffix(word, "ss") &&
+		!strings.HasSuffix(word, "us") &&
+		!strings.HasSuffix(word, "is"):
+		word, _ = cut(word, "s")
+	}
+
+	for _, suffix := range []string{"ations", "ation", "ments", "ment", "ings", "ing", "edly", "ed", "ly", "er"} {
+		stem, ok := cut(word, suffix)
+		if !ok {
+			continue
+		}
+		word = stem
+		if suffix == "ations" || suffix == "ation" {
+			word += "at"
+		}
+		if n := len(word); n > 3 && word[n-1] == word[n-2] && !isVowel(word[n-1]) && strings.IndexByte("lsz", word[n-1]) < 0 {
+			word = word[:n-1]
+		}
+		break
+	}
+
+	if stem, ok := cut(word, "e"); ok {
+		word = stem
+	}
+
+	return word
+}
@@ -0,0 +1,69 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type analyzerSuite struct {
+	suite.Suite
+}
+
+func TestAnalyzerSuite(t *testing.T) {
+	suite.Run(t, new(analyzerSuite))
+}
+
+func (s *analyzerSuite) TestTokenize() {
+	tokens := tokenize("Gedung Serbaguna, kapasitas 500 orang!")
+	s.Equal([]token{
+		{"gedung", 0, 6},
+		{"serbaguna", 7, 16},
+		{"kapasitas", 18, 27},
+		{"500", 28, 31},
+		{"orang", 32, 37},
+	}, tokens)
+}
+
+func (s *analyzerSuite) TestAnalyze() {
+	s.Equal([]string{"wed", "venu", "jakarta"}, Analyze("The Wedding Venues in Jakarta"))
+	s.Equal([]string{"nikah", "gedung"}, Analyze("pernikahan di gedung"))
+}
+
+func (s *analyzerSuite) TestTopWords() {
+	text := "Wedding photography for the whole day. Weddings, engagements and photography prints."
+	s.Equal([]string{"wedding", "photography", "whole"}, TopWords(text, 3))
+	s.Equal([]string{"wedding", "photography", "whole", "day", "engagements", "prints"}, TopWords(text, 10))
+}
+
+func (s *analyzerSuite) TestStem() {
+	testCases := []struct {
+		Word     string
+		Expected string
+	}{
+		{"wedding", "wed"},
+		{"weddings", "wed"},
+		{"decorations", "decorat"},
+		{"decorating", "decorat"},
+		{"parties", "party"},
+		{"catering", "cater"},
+		{"photographer", "photograph"},
+		{"pernikahan", "nikah"},
+		{"menyewakan", "sewa"},
+		{"memotret", "potret"},
+		{"dekorasinya", "dekorasi"},
+		{"tari", "tari"},
+		{"dj", "dj"},
+	}
+
+	for _, testCase := range testCases {
+		s.Equal(testCase.Expected, Stem(testCase.Word), testCase.Word)
+	}
+}
+
+func (s *analyzerSuite) TestLevenshtein() {
+	s.Equal(0, Levenshtein("venue", "venue", 2))
+	s.Equal(1, Levenshtein("venue", "venu", 2))
+	s.Equal(2, Levenshtein("katering", "catring", 2))
+	s.Equal(3, Levenshtein("photography", "video", 2))
+}
@@ -0,0 +1,318 @@
+package search
+
+import (
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Document is one searchable record. Fields are matched with the weights in
+// fieldBoosts; fields not listed there are ignored.
+type Document struct {
+	ID     uint
+	Fields map[string]string
+}
+
+// Hit is a document matching a query, with the matched words of each field
+// wrapped in <mark> tags.
+type Hit struct {
+	ID         uint
+	Score      float64
+	Highlights map[string]string
+}
+
+// Index is a full-text index over documents kept up to date by the caller.
+// While the index is being rebuilt it keeps answering from the previous
+// contents, but Ready reports false so callers can fall back to the
+// database.
+type Index interface {
+	Put(doc Document)
+	Delete(id uint)
+	Rebuild(load func() []Document)
+	Ready() bool
+	Search(query string) []Hit
+}
+
+var fieldBoosts = map[string]float64{
+	"name":        3,
+	"tags":        2,
+	"category":    1.5,
+	"description": 1,
+}
+
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	maxHits         = 1000
+	fragmentPadding = 80
+)
+
+type entry struct {
+	fields  map[string]string
+	lengths map[string]int
+	terms   map[string]map[string]int
+}
+
+type memoryIndex struct {
+	mu         sync.RWMutex
+	entries    map[uint]*entry
+	postings   map[string]map[uint]bool
+	lengths    map[string]int
+	ready      bool
+	rebuilding bool
+	pending    []func(*memoryIndex)
+}
+
+func NewMemoryIndex() Index {
+	return newMemoryIndex()
+}
+
+func newMemoryIndex() *memoryIndex {
+	return &memoryIndex{
+		entries:  make(map[uint]*entry),
+		postings: make(map[string]map[uint]bool),
+		lengths:  make(map[string]int),
+	}
+}
+
+func (i *memoryIndex) Put(doc Document) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.put(doc)
+	if i.rebuilding {
+		i.pending = append(i.pending, func(fresh *memoryIndex) { fresh.put(doc) })
+	}
+}
+
+func (i *memoryIndex) Delete(id uint) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.delete(id)
+	if i.rebuilding {
+		i.pending = append(i.pending, func(fresh *memoryIndex) { fresh.delete(id) })
+	}
+}
+
+// Rebuild replaces the whole index with the documents returned by load.
+// Changes made while load runs are replayed on top of its result, so a
+// document saved during the rebuild is not lost.
+func (i *memoryIndex) Rebuild(load func() []Document) {
+	i.mu.Lock()
+	if i.rebuilding {
+		i.mu.Unlock()
+		return
+	}
+	i.rebuilding = true
+	i.pending = nil
+	i.mu.Unlock()
+
+	fresh := newMemoryIndex()
+	for _, doc := range load() {
+		fresh.put(doc)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, apply := range i.pending {
+		apply(fresh)
+	}
+	i.entries = fresh.entries
+	i.postings = fresh.postings
+	i.lengths = fresh.lengths
+	i.pending = nil
+	i.rebuilding = false
+	i.ready = true
+}
+
+func (i *memoryIndex) Ready() bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.ready && !i.rebuilding
+}
+
+func (i *memoryIndex) Search(query string) []Hit {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	// Each query term matches itself and, weighted down by the number of
+	// edits, indexed terms close enough to be a typo of it.
+	weights := make(map[string]float64)
+	for _, term := range Analyze(query) {
+		max := fuzziness(term)
+		for candidate := range i.postings {
+			distance := 0
+			if candidate != term {
+				if max == 0 {
+					continue
+				}
+				distance = Levenshtein(term, candidate, max)
+				if distance > max {
+					continue
+				}
+			}
+			weight := 1 / float64(1+distance)
+			if weight > weights[candidate] {
+				weights[candidate] = weight
+			}
+		}
+	}
+
+	total := float64(len(i.entries))
+	scores := make(map[uint]float64)
+	matched := make(map[uint]map[string]bool)
+	for term, weight := range weights {
+		docs := i.postings[term]
+		idf := math.Log(1 + (total-float64(len(docs))+0.5)/(float64(len(docs))+0.5))
+		for id := range docs {
+			entry := i.entries[id]
+			for field, boost := range fieldBoosts {
+				tf := float64(entry.terms[field][term])
+				if tf == 0 {
+					continue
+				}
+				average := float64(i.lengths[field]) / total
+				norm := 1 - bm25B + bm25B*float64(entry.lengths[field])/average
+				scores[id] += weight * boost * idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+			}
+			if matched[id] == nil {
+				matched[id] = make(map[string]bool)
+			}
+			matched[id][term] = true
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, Hit{ID: id, Score: score})
+	}
+	sort.Slice(hits, func(a, b int) bool {
+		if hits[a].Score != hits[b].Score {
+			return hits[a].Score > hits[b].Score
+		}
+		return hits[a].ID < hits[b].ID
+	})
+	if len(hits) > maxHits {
+		hits = hits[:maxHits]
+	}
+
+	for n := range hits {
+		hits[n].Highlights = make(map[string]string)
+		for field, text := range i.entries[hits[n].ID].fields {
+			if highlighted, ok := highlight(text, matched[hits[n].ID]); ok {
+				hits[n].Highlights[field] = highlighted
+			}
+		}
+	}
+
+	return hits
+}
+
+func (i *memoryIndex) put(doc Document) {
+	i.delete(doc.ID)
+
+	entry := &entry{
+		fields:  make(map[string]string),
+		lengths: make(map[string]int),
+		terms:   make(map[string]map[string]int),
+	}
+	for field, text := range doc.Fields {
+		if _, ok := fieldBoosts[field]; !ok {
+			continue
+		}
+
+		terms := Analyze(text)
+		entry.fields[field] = text
+		entry.lengths[field] = len(terms)
+		entry.terms[field] = make(map[string]int)
+		i.lengths[field] += len(terms)
+
+		for _, term := range terms {
+			entry.terms[field][term]++
+			if i.postings[term] == nil {
+				i.postings[term] = make(map[uint]bool)
+			}
+			i.postings[term][doc.ID] = true
+		}
+	}
+	i.entries[doc.ID] = entry
+}
+
+func (i *memoryIndex) delete(id uint) {
+	entry, ok := i.entries[id]
+	if !ok {
+		return
+	}
+
+	for field, terms := range entry.terms {
+		i.lengths[field] -= entry.lengths[field]
+		for term := range terms {
+			delete(i.postings[term], id)
+			if len(i.postings[term]) == 0 {
+				delete(i.postings, term)
+			}
+		}
+	}
+	delete(i.entries, id)
+}
+
+// highlight escapes text and wraps the words whose terms are in matched
+// with <mark> tags. Long text is cut down to a fragment around the first
+// match.
+func highlight(text string, matched map[string]bool) (string, bool) {
+	tokens := tokenize(text)
+	marks := make([]token, 0)
+	for _, token := range tokens {
+		if matched[analyzeWord(token.Text)] {
+			marks = append(marks, token)
+		}
+	}
+	if len(marks) == 0 {
+		return "", false
+	}
+
+	start, end := 0, len(text)
+	if marks[0].Start > fragmentPadding {
+		start = marks[0].Start - fragmentPadding
+	}
+	if marks[0].End+fragmentPadding < end {
+		end = marks[0].End + fragmentPadding
+	}
+	// Keep whole words at both ends of the fragment.
+	for _, token := range tokens {
+		if token.Start < start && token.End > start {
+			start = token.Start
+		}
+		if token.Start < end && token.End > end {
+			end = token.End
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	last := start
+	for _, mark := range marks {
+		if mark.Start < start || mark.End > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[last:mark.Start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[mark.Start:mark.End]))
+		b.WriteString("</mark>")
+		last = mark.End
+	}
+	b.WriteString(html.EscapeString(text[last:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+
+	return b.String(), true
+}
@@ -0,0 +1,113 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type indexSuite struct {
+	suite.Suite
+	index Index
+}
+
+func (s *indexSuite) SetupTest() {
+	s.index = NewMemoryIndex()
+	s.index.Rebuild(func() []Document {
+		return []Document{
+			{ID: 1, Fields: map[string]string{
+				"name":        "Wedding Venue",
+				"description": "Garden venue for weddings up to 300 guests.",
+				"category":    "Venue",
+			}},
+			{ID: 2, Fields: map[string]string{
+				"name":        "Catering Nusantara",
+				"description": "Paket katering untuk pernikahan dan acara kantor.",
+				"tags":        "buffet halal",
+			}},
+			{ID: 3, Fields: map[string]string{
+				"name":        "Photo Booth",
+				"description": "Photo booth for weddings, birthdays and corporate events.",
+			}},
+		}
+	})
+}
+
+func TestIndexSuite(t *testing.T) {
+	suite.Run(t, new(indexSuite))
+}
+
+func (s *indexSuite) ids(hits []Hit) []uint {
+	ids := make([]uint, 0)
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids
+}
+
+func (s *indexSuite) TestReady() {
+	s.False(NewMemoryIndex().Ready())
+	s.True(s.index.Ready())
+}
+
+func (s *indexSuite) TestSearchRanksByRelevance() {
+	hits := s.index.Search("wedding")
+	s.Equal([]uint{1, 3}, s.ids(hits))
+	s.Greater(hits[0].Score, hits[1].Score)
+}
+
+func (s *indexSuite) TestSearchStemming() {
+	s.Equal([]uint{2}, s.ids(s.index.Search("nikah")))
+	s.Equal([]uint{1, 3}, s.ids(s.index.Search("weddings")))
+}
+
+func (s *indexSuite) TestSearchFuzzy() {
+	s.Equal([]uint{1}, s.ids(s.index.Search("vanue")))
+	s.Equal([]uint{2}, s.ids(s.index.Search("cattering")))
+	s.Empty(s.index.Search("xyz"))
+}
+
+func (s *indexSuite) TestSearchHighlights() {
+	hits := s.index.Search("garden weddings")
+	s.Equal(uint(1), hits[0].ID)
+	s.Equal("<mark>Wedding</mark> Venue", hits[0].Highlights["name"])
+	s.Equal("<mark>Garden</mark> venue for <mark>weddings</mark> up to 300 guests.", hits[0].Highlights["description"])
+	s.NotContains(hits[0].Highlights, "category")
+}
+
+func (s *indexSuite) TestHighlightFragment() {
+	text := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor " +
+		"incididunt ut labore et dolore magna aliqua <venue> ut enim ad minim veniam, quis nostrud " +
+		"exercitation ullamco laboris nisi ut aliquip ex ea commodo consequat."
+
+	highlighted, ok := highlight(text, map[string]bool{"venu": true})
+	s.True(ok)
+	s.Equal("…adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua "+
+		"&lt;<mark>venue</mark>&gt; ut enim ad minim veniam, quis nostrud exercitation ullamco laboris nisi ut aliquip…", highlighted)
+}
+
+func (s *indexSuite) TestPutAndDelete() {
+	s.index.Put(Document{ID: 4, Fields: map[string]string{"name": "Wedding Organizer"}})
+	s.Contains(s.ids(s.index.Search("wedding")), uint(4))
+
+	s.index.Put(Document{ID: 4, Fields: map[string]string{"name": "Sound System"}})
+	s.NotContains(s.ids(s.index.Search("wedding")), uint(4))
+
+	s.index.Delete(1)
+	s.Equal([]uint{3}, s.ids(s.index.Search("wedding")))
+}
+
+func (s *indexSuite) TestRebuildKeepsConcurrentChanges() {
+	s.index.Rebuild(func() []Document {
+		s.False(s.index.Ready())
+		s.index.Put(Document{ID: 5, Fields: map[string]string{"name": "Wedding Band"}})
+		s.index.Delete(3)
+		return []Document{
+			{ID: 1, Fields: map[string]string{"name": "Wedding Venue"}},
+			{ID: 3, Fields: map[string]string{"name": "Photo Booth for weddings"}},
+		}
+	})
+
+	s.True(s.index.Ready())
+	s.ElementsMatch([]uint{1, 5}, s.ids(s.index.Search("wedding")))
+}
@@ -0,0 +1,60 @@
+package search
+
+// Levenshtein returns the edit distance between a and b, giving up with
+// max+1 as soon as the distance is known to exceed max.
+func Levenshtein(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if diff := len(ra) - len(rb); diff > max || -diff > max {
+		return max + 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		best := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+			if curr[j] < best {
+				best = curr[j]
+			}
+		}
+		if best > max {
+			return max + 1
+		}
+		prev, curr = curr, prev
+	}
+
+	if prev[len(rb)] > max {
+		return max + 1
+	}
+
+	return prev[len(rb)]
+}
+
+// fuzziness is how many edits a query term of the given length may be away
+// from an indexed term and still match it.
+func fuzziness(term string) int {
+	switch n := len([]rune(term)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
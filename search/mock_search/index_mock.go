@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./search/index.go
+
+// Package mock_search is a generated GoMock package.
+package mock_search
+
+import (
+	reflect "reflect"
+
+	search "github.com/andikabahari/eoplatform/search"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIndex is a mock of Index interface.
+type MockIndex struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexMockRecorder
+}
+
+// MockIndexMockRecorder is the mock recorder for MockIndex.
+type MockIndexMockRecorder struct {
+	mock *MockIndex
+}
+
+// NewMockIndex creates a new mock instance.
+func NewMockIndex(ctrl *gomock.Controller) *MockIndex {
+	mock := &MockIndex{ctrl: ctrl}
+	mock.recorder = &MockIndexMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndex) EXPECT() *MockIndexMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIndex) Delete(id uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", id)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIndexMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIndex)(nil).Delete), id)
+}
+
+// Put mocks base method.
+func (m *MockIndex) Put(doc search.Document) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Put", doc)
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockIndexMockRecorder) Put(doc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockIndex)(nil).Put), doc)
+}
+
+// Ready mocks base method.
+func (m *MockIndex) Ready() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ready")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Ready indicates an expected call of Ready.
+func (mr *MockIndexMockRecorder) Ready() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockIndex)(nil).Ready))
+}
+
+// Rebuild mocks base method.
+func (m *MockIndex) Rebuild(load func() []search.Document) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Rebuild", load)
+}
+
+// Rebuild indicates an expected call of Rebuild.
+func (mr *MockIndexMockRecorder) Rebuild(load interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebuild", reflect.TypeOf((*MockIndex)(nil).Rebuild), load)
+}
+
+// Search mocks base method.
+func (m *MockIndex) Search(query string) []search.Hit {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", query)
+	ret0, _ := ret[0].([]search.Hit)
+	return ret0
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockIndexMockRecorder) Search(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockIndex)(nil).Search), query)
+}
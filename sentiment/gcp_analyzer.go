@@ -0,0 +1,62 @@
+package sentiment
+
+import (
+	"context"
+	"sync"
+
+	language "cloud.google.com/go/language/apiv1"
+	languagepb "google.golang.org/genproto/googleapis/cloud/language/v1"
+)
+
+type gcpAnalyzer struct {
+	mu     sync.Mutex
+	client *language.Client
+}
+
+// NewGCPAnalyzer scores text with the Google Cloud Natural Language API.
+// The client is created on first use and kept for later calls, so the
+// application still starts when credentials are missing.
+func NewGCPAnalyzer() SentimentAnalyzer {
+	return &gcpAnalyzer{}
+}
+
+func (a *gcpAnalyzer) Analyze(text string) (float64, error) {
+	ctx := context.Background()
+
+	client, err := a.getClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sentiment, err := client.AnalyzeSentiment(ctx, &languagepb.AnalyzeSentimentRequest{
+		Document: &languagepb.Document{
+			Source: &languagepb.Document_Content{
+				Content: text,
+			},
+			Type: languagepb.Document_PLAIN_TEXT,
+		},
+		EncodingType: languagepb.EncodingType_UTF8,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(sentiment.DocumentSentiment.Score), nil
+}
+
+// getClient returns the shared client, creating it if no earlier call
+// managed to.
+func (a *gcpAnalyzer) getClient(ctx context.Context) (*language.Client, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.client == nil {
+		client, err := language.NewClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		a.client = client
+	}
+
+	return a.client, nil
+}
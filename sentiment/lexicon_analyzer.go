@@ -0,0 +1,134 @@
+package sentiment
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/andikabahari/eoplatform/search"
+)
+
+// Words of feedback in Indonesian and English with their polarity. They
+// are stemmed the same way as the text being scored, so one entry covers
+// the inflected forms too ("puas" also matches "memuaskan").
+var polarities = map[string]float64{
+	// Indonesian
+	"bagus": 1, "baik": 1, "mantap": 1, "puas": 1, "ramah": 1, "rapi": 1,
+	"cepat": 1, "tepat": 1, "profesional": 1, "keren": 1, "indah": 1,
+	"cantik": 1, "enak": 1, "lezat": 1, "murah": 1, "suka": 1, "senang": 1,
+	"hebat": 1, "sempurna": 1, "nyaman": 1, "responsif": 1, "sopan": 1,
+	"rekomendasi": 1, "makasih": 1, "memuaskan": 1, "terbaik": 1,
+	"buruk": -1, "jelek": -1, "kecewa": -1, "lambat": -1, "telat": -1,
+	"mahal": -1, "kotor": -1, "kasar": -1, "berantakan": -1, "parah": -1,
+	"gagal": -1, "rusak": -1, "bohong": -1, "tipu": -1, "marah": -1,
+	"kacau": -1, "payah": -1, "hancur": -1, "basi": -1, "hambar": -1,
+	"lelet": -1, "mengecewakan": -1, "terlambat": -1,
+
+	// English
+	"good": 1, "great": 1, "excellent": 1, "amazing": 1, "awesome": 1,
+	"nice": 1, "friendly": 1, "professional": 1, "perfect": 1,
+	"beautiful": 1, "wonderful": 1, "love": 1, "recommend": 1,
+	"satisfied": 1, "happy": 1, "fast": 1, "helpful": 1, "delicious": 1,
+	"clean": 1, "best": 1, "thanks": 1,
+	"bad": -1, "poor": -1, "terrible": -1, "awful": -1, "horrible": -1,
+	"disappointed": -1, "disappointing": -1, "late": -1, "slow": -1,
+	"rude": -1, "dirty": -1, "expensive": -1, "worst": -1, "broken": -1,
+	"messy": -1, "unprofessional": -1, "hate": -1, "unhelpful": -1,
+}
+
+// negators flip the polarity of the next sentiment word within
+// negationWindow words: "tidak ramah", "not very good".
+var negators = map[string]bool{
+	"tidak": true, "tak": true, "bukan": true, "kurang": true, "belum": true,
+	"gak": true, "nggak": true, "enggak": true, "ga": true, "ndak": true,
+	"not": true, "no": true, "never": true, "don": true, "didn": true,
+	"doesn": true, "isn": true, "wasn": true, "weren": true, "hardly": true,
+}
+
+// Intensifiers strengthen the sentiment word after them, or before them
+// for the Indonesian ones written after the word ("bagus banget").
+var (
+	intensifiers = map[string]bool{
+		"sangat": true, "amat": true, "paling": true, "terlalu": true,
+		"very": true, "really": true, "so": true, "extremely": true, "super": true,
+	}
+	trailingIntensifiers = map[string]bool{
+		"banget": true, "sekali": true, "bgt": true,
+	}
+)
+
+const (
+	negationWindow = 3
+	negatedWeight  = 0.75
+	intensifiedBy  = 1.5
+
+	// normalization bends the summed polarities into (-1, 1); a single
+	// sentiment word scores about 0.58 and three about 0.9.
+	normalization = 2
+)
+
+type lexiconAnalyzer struct {
+	polarities map[string]float64
+}
+
+// NewLexiconAnalyzer scores text offline by looking its words up in a
+// small Indonesian and English lexicon, taking negation and intensifiers
+// into account. It never fails.
+func NewLexiconAnalyzer() SentimentAnalyzer {
+	a := lexiconAnalyzer{make(map[string]float64)}
+	for word, polarity := range polarities {
+		a.polarities[search.Stem(word)] = polarity
+	}
+
+	return &a
+}
+
+func (a *lexiconAnalyzer) Analyze(text string) (float64, error) {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	total := 0.0
+	// previous is the score of the word just before, if it was a sentiment
+	// word, for a trailing intensifier to strengthen.
+	previous := 0.0
+	negatedUntil := -1
+	intensified := false
+	for i, word := range words {
+		switch {
+		case negators[word]:
+			negatedUntil = i + negationWindow
+			previous = 0
+			continue
+		case intensifiers[word]:
+			intensified = true
+			previous = 0
+			continue
+		case trailingIntensifiers[word]:
+			total += previous * (intensifiedBy - 1)
+			previous = 0
+			continue
+		}
+
+		polarity, ok := a.polarities[search.Stem(word)]
+		if !ok {
+			intensified = false
+			previous = 0
+			continue
+		}
+
+		score := polarity
+		if intensified {
+			score *= intensifiedBy
+		}
+		if i <= negatedUntil {
+			score *= -negatedWeight
+			negatedUntil = -1
+		}
+		total += score
+		previous = score
+		intensified = false
+	}
+
+	return total / math.Sqrt(total*total+normalization), nil
+}
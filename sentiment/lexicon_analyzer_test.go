@@ -0,0 +1,55 @@
+package sentiment
+
+import (
+	"testing"
+
+	"github.com/andikabahari/eoplatform/search"
+	"github.com/stretchr/testify/suite"
+)
+
+type lexiconAnalyzerSuite struct {
+	suite.Suite
+	analyzer SentimentAnalyzer
+}
+
+func (s *lexiconAnalyzerSuite) SetupTest() {
+	s.analyzer = NewLexiconAnalyzer()
+}
+
+func TestLexiconAnalyzerSuite(t *testing.T) {
+	suite.Run(t, new(lexiconAnalyzerSuite))
+}
+
+func (s *lexiconAnalyzerSuite) TestAnalyze() {
+	testCases := []struct {
+		Text string
+		Min  float64
+		Max  float64
+	}{
+		{"Good job!", 0.5, 0.6},
+		{"Pelayanannya ramah dan dekorasinya bagus banget, sangat memuaskan!", 0.9, 1},
+		{"Tidak ramah dan datang terlambat.", -1, -0.7},
+		{"Not very professional, the food was cold.", -0.7, -0.5},
+		{"Makanannya enak tapi harganya mahal", -0.01, 0.01},
+		{"Acara tanggal 12 Desember", 0, 0},
+		{"", 0, 0},
+	}
+
+	for _, testCase := range testCases {
+		score, err := s.analyzer.Analyze(testCase.Text)
+		s.NoError(err)
+		s.GreaterOrEqual(score, testCase.Min, testCase.Text)
+		s.LessOrEqual(score, testCase.Max, testCase.Text)
+	}
+}
+
+func (s *lexiconAnalyzerSuite) TestStemsDoNotCollide() {
+	seen := make(map[string]float64)
+	for word, polarity := range polarities {
+		stem := search.Stem(word)
+		if previous, ok := seen[stem]; ok {
+			s.Equal(previous, polarity, word)
+		}
+		seen[stem] = polarity
+	}
+}
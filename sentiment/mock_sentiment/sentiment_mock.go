@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./sentiment/sentiment.go
+
+// Package mock_sentiment is a generated GoMock package.
+package mock_sentiment
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSentimentAnalyzer is a mock of SentimentAnalyzer interface.
+type MockSentimentAnalyzer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSentimentAnalyzerMockRecorder
+}
+
+// MockSentimentAnalyzerMockRecorder is the mock recorder for MockSentimentAnalyzer.
+type MockSentimentAnalyzerMockRecorder struct {
+	mock *MockSentimentAnalyzer
+}
+
+// NewMockSentimentAnalyzer creates a new mock instance.
+func NewMockSentimentAnalyzer(ctrl *gomock.Controller) *MockSentimentAnalyzer {
+	mock := &MockSentimentAnalyzer{ctrl: ctrl}
+	mock.recorder = &MockSentimentAnalyzerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSentimentAnalyzer) EXPECT() *MockSentimentAnalyzerMockRecorder {
+	return m.recorder
+}
+
+// Analyze mocks base method.
+func (m *MockSentimentAnalyzer) Analyze(text string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Analyze", text)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Analyze indicates an expected call of Analyze.
+func (mr *MockSentimentAnalyzerMockRecorder) Analyze(text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Analyze", reflect.TypeOf((*MockSentimentAnalyzer)(nil).Analyze), text)
+}
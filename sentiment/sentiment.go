@@ -0,0 +1,17 @@
+package sentiment
+
+import "github.com/andikabahari/eoplatform/config"
+
+// SentimentAnalyzer scores how positive text is, from -1 for clearly
+// negative to 1 for clearly positive.
+type SentimentAnalyzer interface {
+	Analyze(text string) (float64, error)
+}
+
+func New(config config.SentimentConfig) SentimentAnalyzer {
+	if config.Analyzer == "lexicon" {
+		return NewLexiconAnalyzer()
+	}
+
+	return NewGCPAnalyzer()
+}
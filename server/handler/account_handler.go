@@ -118,3 +118,56 @@ func (h *AccountHandler) ResetPassword(c echo.Context) error {
 		},
 	})
 }
+
+func (h *AccountHandler) GetProfile(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "fetch profile failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	profile := model.OrganizerProfile{}
+	h.usecase.GetProfile(claims, &profile)
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch profile successful",
+		"data":    response.NewOrganizerProfileResponse(profile),
+	})
+}
+
+func (h *AccountHandler) UpdateProfile(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "update profile failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.UpdateOrganizerProfileRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	profile := model.OrganizerProfile{}
+	h.usecase.UpdateProfile(claims, &profile, &req)
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "update profile successful",
+		"data":    response.NewOrganizerProfileResponse(profile),
+	})
+}
@@ -264,3 +264,145 @@ func (s *accountHandlerSuite) TestResetPassword() {
 		})
 	}
 }
+
+func (s *accountHandlerSuite) TestGetProfile() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/account/profile",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+		{
+			"ok",
+			"/v1/account/profile",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetProfile(gomock.Any(), gomock.Any())
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.GetProfile(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *accountHandlerSuite) TestUpdateProfile() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         *request.UpdateOrganizerProfileRequest
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/account/profile",
+			nil,
+			http.MethodPut,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+		{
+			"bad request",
+			"/v1/account/profile",
+			nil,
+			http.MethodPut,
+			&request.UpdateOrganizerProfileRequest{
+				DisplayName: "Bali Weddings",
+				SocialLinks: map[string]string{"instagram": "not a url"},
+			},
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"ok",
+			"/v1/account/profile",
+			nil,
+			http.MethodPut,
+			&request.UpdateOrganizerProfileRequest{
+				DisplayName: "Bali Weddings",
+				City:        "Denpasar",
+				SocialLinks: map[string]string{"instagram": "https://instagram.com/baliweddings"},
+				FoundedYear: 2015,
+			},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().UpdateProfile(gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.UpdateProfile(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
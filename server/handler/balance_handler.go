@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+type BalanceHandler struct {
+	usecase u.BalanceUsecase
+}
+
+func NewBalanceHandler(usecase u.BalanceUsecase) *BalanceHandler {
+	return &BalanceHandler{usecase}
+}
+
+func (h *BalanceHandler) GetBalance(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "fetch balance failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	balance := model.Balance{}
+	h.usecase.GetBalance(claims, &balance)
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch balance successful",
+		"data":    response.NewBalanceResponse(balance),
+	})
+}
+
+func (h *BalanceHandler) GetTransactions(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "fetch transactions failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	entries := make([]model.LedgerEntry, 0)
+	h.usecase.GetTransactions(claims, &entries)
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch transactions successful",
+		"data":    response.NewLedgerEntriesResponse(entries),
+	})
+}
+
+func (h *BalanceHandler) GetEarnings(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "fetch earnings failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	payments := make([]model.Payment, 0)
+	h.usecase.GetEarnings(claims, &payments)
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch earnings successful",
+		"data":    response.NewEarningsResponse(payments),
+	})
+}
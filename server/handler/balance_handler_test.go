@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/server"
+	"github.com/andikabahari/eoplatform/testhelper"
+	mu "github.com/andikabahari/eoplatform/usecase/mock_usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type balanceHandlerSuite struct {
+	suite.Suite
+
+	ctrl    *gomock.Controller
+	usecase *mu.MockBalanceUsecase
+
+	server  *server.Server
+	handler *BalanceHandler
+}
+
+func (s *balanceHandlerSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.usecase = mu.NewMockBalanceUsecase(s.ctrl)
+
+	conn, _ := testhelper.Mock()
+	s.server = testhelper.NewServer(conn)
+	s.handler = NewBalanceHandler(s.usecase)
+}
+
+func TestBalanceHandlerSuite(t *testing.T) {
+	suite.Run(t, new(balanceHandlerSuite))
+}
+
+func (s *balanceHandlerSuite) TestGetBalance() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/balance",
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "customer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/balance",
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetBalance(gomock.Any(), gomock.Any())
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+
+			s.NoError(s.handler.GetBalance(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *balanceHandlerSuite) TestGetTransactions() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/balance/transactions",
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "customer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/balance/transactions",
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetTransactions(gomock.Any(), gomock.Any())
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+
+			s.NoError(s.handler.GetTransactions(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *balanceHandlerSuite) TestGetEarnings() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/balance/earnings",
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "customer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/balance/earnings",
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetEarnings(gomock.Any(), gomock.Any())
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+
+			s.NoError(s.handler.GetEarnings(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
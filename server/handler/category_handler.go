@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+type CategoryHandler struct {
+	usecase u.CategoryUsecase
+}
+
+func NewCategoryHandler(usecase u.CategoryUsecase) *CategoryHandler {
+	return &CategoryHandler{usecase}
+}
+
+func (h *CategoryHandler) GetCategories(c echo.Context) error {
+	categories := make([]model.Category, 0)
+	h.usecase.GetCategories(&categories)
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch categories successful",
+		"data":    response.NewCategoriesResponse(categories),
+	})
+}
+
+func (h *CategoryHandler) CreateCategory(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	req := request.CreateCategoryRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	category := model.Category{}
+
+	if apiError := h.usecase.CreateCategory(claims, &category, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "create category failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "create category successful",
+		"data":    response.NewCategoryResponse(category),
+	})
+}
+
+func (h *CategoryHandler) UpdateCategory(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	req := request.UpdateCategoryRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	category := model.Category{}
+
+	if apiError := h.usecase.UpdateCategory(claims, c, &category, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "update category failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "update category successful",
+		"data":    response.NewCategoryResponse(category),
+	})
+}
+
+func (h *CategoryHandler) DeleteCategory(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	category := model.Category{}
+
+	if apiError := h.usecase.DeleteCategory(claims, c, &category); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "delete category failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "delete category successful",
+		"data": echo.Map{
+			"kind":    "category",
+			"id":      c.Param("id"),
+			"deleted": true,
+		},
+	})
+}
@@ -0,0 +1,368 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/server"
+	"github.com/andikabahari/eoplatform/testhelper"
+	mu "github.com/andikabahari/eoplatform/usecase/mock_usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type categoryHandlerSuite struct {
+	suite.Suite
+
+	ctrl    *gomock.Controller
+	usecase *mu.MockCategoryUsecase
+
+	server  *server.Server
+	handler *CategoryHandler
+}
+
+func (s *categoryHandlerSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.usecase = mu.NewMockCategoryUsecase(s.ctrl)
+
+	conn, _ := testhelper.Mock()
+	s.server = testhelper.NewServer(conn)
+	s.handler = NewCategoryHandler(s.usecase)
+}
+
+func TestCategoryHandlerSuite(t *testing.T) {
+	suite.Run(t, new(categoryHandlerSuite))
+}
+
+func (s *categoryHandlerSuite) TestGetCategories() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"ok",
+			"/v1/categories",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetCategories(gomock.Any())
+			},
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.GetCategories(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *categoryHandlerSuite) TestCreateCategory() {
+	admin := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "admin"})
+
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/categories",
+			nil,
+			http.MethodPost,
+			&request.CreateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "venue"},
+			},
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().CreateCategory(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "organizer"}),
+		},
+		{
+			"validation error",
+			"/v1/categories",
+			nil,
+			http.MethodPost,
+			&request.CreateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "Not A Slug"},
+			},
+			http.StatusBadRequest,
+			func() {},
+			admin,
+		},
+		{
+			"bad request",
+			"/v1/categories",
+			nil,
+			http.MethodPost,
+			&request.CreateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "venue"},
+			},
+			http.StatusBadRequest,
+			func() {
+				apiError := helper.NewAPIError(http.StatusBadRequest, "")
+				s.usecase.EXPECT().CreateCategory(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			admin,
+		},
+		{
+			"ok",
+			"/v1/categories",
+			nil,
+			http.MethodPost,
+			&request.CreateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "venue"},
+			},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().CreateCategory(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			admin,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.CreateCategory(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *categoryHandlerSuite) TestUpdateCategory() {
+	admin := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "admin"})
+	pathParam := &testhelper.PathParam{
+		Names:  []string{"id"},
+		Values: []string{"1"},
+	}
+
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/categories/:id",
+			pathParam,
+			http.MethodPut,
+			&request.UpdateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "venue"},
+			},
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().UpdateCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "customer"}),
+		},
+		{
+			"not found",
+			"/v1/categories/:id",
+			pathParam,
+			http.MethodPut,
+			&request.UpdateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "venue"},
+			},
+			http.StatusNotFound,
+			func() {
+				apiError := helper.NewAPIError(http.StatusNotFound, "")
+				s.usecase.EXPECT().UpdateCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			admin,
+		},
+		{
+			"ok",
+			"/v1/categories/:id",
+			pathParam,
+			http.MethodPut,
+			&request.UpdateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "venue"},
+			},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().UpdateCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			admin,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.UpdateCategory(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *categoryHandlerSuite) TestDeleteCategory() {
+	admin := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "admin"})
+	pathParam := &testhelper.PathParam{
+		Names:  []string{"id"},
+		Values: []string{"1"},
+	}
+
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/categories/:id",
+			pathParam,
+			http.MethodDelete,
+			nil,
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().DeleteCategory(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "organizer"}),
+		},
+		{
+			"bad request",
+			"/v1/categories/:id",
+			pathParam,
+			http.MethodDelete,
+			nil,
+			http.StatusBadRequest,
+			func() {
+				apiError := helper.NewAPIError(http.StatusBadRequest, "")
+				s.usecase.EXPECT().DeleteCategory(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			admin,
+		},
+		{
+			"ok",
+			"/v1/categories/:id",
+			pathParam,
+			http.MethodDelete,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().DeleteCategory(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			admin,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.DeleteCategory(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+type CommissionRateHandler struct {
+	usecase u.CommissionRateUsecase
+}
+
+func NewCommissionRateHandler(usecase u.CommissionRateUsecase) *CommissionRateHandler {
+	return &CommissionRateHandler{usecase}
+}
+
+func (h *CommissionRateHandler) GetCommissionRates(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	rates := make([]model.CommissionRate, 0)
+
+	if apiError := h.usecase.GetCommissionRates(claims, &rates); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "fetch commission rates failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch commission rates successful",
+		"data":    response.NewCommissionRatesResponse(rates),
+	})
+}
+
+func (h *CommissionRateHandler) SetCommissionRate(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	req := request.SetCommissionRateRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	rate := model.CommissionRate{}
+
+	if apiError := h.usecase.SetCommissionRate(claims, &rate, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "set commission rate failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "set commission rate successful",
+		"data":    response.NewCommissionRateResponse(rate),
+	})
+}
+
+func (h *CommissionRateHandler) DeleteCommissionRate(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	rate := model.CommissionRate{}
+
+	if apiError := h.usecase.DeleteCommissionRate(claims, &rate, c.Param("id")); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "delete commission rate failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "delete commission rate successful",
+		"data": echo.Map{
+			"kind":    "commission_rate",
+			"id":      c.Param("id"),
+			"deleted": true,
+		},
+	})
+}
@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/server"
+	"github.com/andikabahari/eoplatform/testhelper"
+	mu "github.com/andikabahari/eoplatform/usecase/mock_usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type commissionRateHandlerSuite struct {
+	suite.Suite
+
+	ctrl    *gomock.Controller
+	usecase *mu.MockCommissionRateUsecase
+
+	server  *server.Server
+	handler *CommissionRateHandler
+}
+
+func (s *commissionRateHandlerSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.usecase = mu.NewMockCommissionRateUsecase(s.ctrl)
+
+	conn, _ := testhelper.Mock()
+	s.server = testhelper.NewServer(conn)
+	s.handler = NewCommissionRateHandler(s.usecase)
+}
+
+func TestCommissionRateHandlerSuite(t *testing.T) {
+	suite.Run(t, new(commissionRateHandlerSuite))
+}
+
+func (s *commissionRateHandlerSuite) TestGetCommissionRates() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/commission-rates",
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().GetCommissionRates(gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/commission-rates",
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetCommissionRates(gomock.Any(), gomock.Any())
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+
+			s.NoError(s.handler.GetCommissionRates(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *commissionRateHandlerSuite) TestSetCommissionRate() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		Method       string
+		Body         *request.SetCommissionRateRequest
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/commission-rates",
+			http.MethodPut,
+			&request.SetCommissionRateRequest{Scope: "organizer", ScopeID: 1, Rate: 0.05},
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().SetCommissionRate(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"bad request",
+			"/v1/commission-rates",
+			http.MethodPut,
+			&request.SetCommissionRateRequest{Scope: "customer", ScopeID: 1, Rate: 2},
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+		{
+			"ok",
+			"/v1/commission-rates",
+			http.MethodPut,
+			&request.SetCommissionRateRequest{Scope: "organizer", ScopeID: 1, Rate: 0.05},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().SetCommissionRate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+
+			s.NoError(s.handler.SetCommissionRate(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *commissionRateHandlerSuite) TestDeleteCommissionRate() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"not found",
+			"/v1/commission-rates/:id",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodDelete,
+			nil,
+			http.StatusNotFound,
+			func() {
+				apiError := helper.NewAPIError(http.StatusNotFound, "")
+				s.usecase.EXPECT().DeleteCommissionRate(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+		{
+			"ok",
+			"/v1/commission-rates/:id",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodDelete,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().DeleteCommissionRate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.DeleteCommissionRate(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
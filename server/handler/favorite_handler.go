@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+type FavoriteHandler struct {
+	usecase u.FavoriteUsecase
+}
+
+func NewFavoriteHandler(usecase u.FavoriteUsecase) *FavoriteHandler {
+	return &FavoriteHandler{usecase}
+}
+
+func (h *FavoriteHandler) GetFavorites(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "customer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "fetch favorites failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	services := make([]model.Service, 0)
+	h.usecase.GetFavorites(claims, &services)
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch favorites successful",
+		"data":    response.NewServicesResponse(services),
+	})
+}
+
+func (h *FavoriteHandler) AddFavorite(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "customer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "add favorite failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	service := model.Service{}
+
+	if apiError := h.usecase.AddFavorite(claims, &service, c.Param("serviceId")); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "add favorite failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "add favorite successful",
+		"data":    response.NewServiceResponse(service),
+	})
+}
+
+func (h *FavoriteHandler) RemoveFavorite(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "customer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "remove favorite failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	if apiError := h.usecase.RemoveFavorite(claims, c.Param("serviceId")); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "remove favorite failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "remove favorite successful",
+		"data": echo.Map{
+			"kind":    "favorite",
+			"id":      c.Param("serviceId"),
+			"deleted": true,
+		},
+	})
+}
@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/server"
+	"github.com/andikabahari/eoplatform/testhelper"
+	mu "github.com/andikabahari/eoplatform/usecase/mock_usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type favoriteHandlerSuite struct {
+	suite.Suite
+
+	ctrl    *gomock.Controller
+	usecase *mu.MockFavoriteUsecase
+
+	server  *server.Server
+	handler *FavoriteHandler
+}
+
+func (s *favoriteHandlerSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.usecase = mu.NewMockFavoriteUsecase(s.ctrl)
+
+	conn, _ := testhelper.Mock()
+	s.server = testhelper.NewServer(conn)
+	s.handler = NewFavoriteHandler(s.usecase)
+}
+
+func (s *favoriteHandlerSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestFavoriteHandlerSuite(t *testing.T) {
+	suite.Run(t, new(favoriteHandlerSuite))
+}
+
+func (s *favoriteHandlerSuite) TestGetFavorites() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/favorites",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"ok",
+			"/v1/favorites",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetFavorites(gomock.Any(), gomock.Any())
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.GetFavorites(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *favoriteHandlerSuite) TestAddFavorite() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/favorites",
+			&testhelper.PathParam{
+				Names:  []string{"serviceId"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"not found",
+			"/v1/favorites",
+			&testhelper.PathParam{
+				Names:  []string{"serviceId"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusNotFound,
+			func() {
+				apiError := helper.NewAPIError(http.StatusNotFound, "")
+				s.usecase.EXPECT().AddFavorite(gomock.Any(), gomock.Any(), gomock.Eq("1")).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+		{
+			"ok",
+			"/v1/favorites",
+			&testhelper.PathParam{
+				Names:  []string{"serviceId"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().AddFavorite(gomock.Any(), gomock.Any(), gomock.Eq("1")).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.AddFavorite(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *favoriteHandlerSuite) TestRemoveFavorite() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/favorites",
+			&testhelper.PathParam{
+				Names:  []string{"serviceId"},
+				Values: []string{"1"},
+			},
+			http.MethodDelete,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"ok",
+			"/v1/favorites",
+			&testhelper.PathParam{
+				Names:  []string{"serviceId"},
+				Values: []string{"1"},
+			},
+			http.MethodDelete,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().RemoveFavorite(gomock.Any(), gomock.Eq("1")).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.RemoveFavorite(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
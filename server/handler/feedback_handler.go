@@ -55,10 +55,56 @@ func (h *FeedbackHandler) CreateFeedback(c echo.Context) error {
 	}
 
 	feedback := model.Feedback{}
-	h.usecase.CreateFeedback(claims, &feedback, &req)
+	if apiError := h.usecase.CreateFeedback(claims, &feedback, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "create feedback failure",
+			"error":   message,
+		})
+	}
 
 	return c.JSON(http.StatusOK, echo.Map{
 		"message": "create feedback successful",
 		"data":    response.NewFeedbackResponse(feedback),
 	})
 }
+
+func (h *FeedbackHandler) ReplyFeedback(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "reply feedback failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.ReplyFeedbackRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	feedback := model.Feedback{}
+
+	if apiError := h.usecase.ReplyFeedback(claims, &feedback, c.Param("id"), &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "reply feedback failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "reply feedback successful",
+		"data":    response.NewFeedbackResponse(feedback),
+	})
+}
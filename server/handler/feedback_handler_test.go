@@ -117,6 +117,21 @@ func (s *feedbackHandlerSuite) TestCreateFeedback() {
 			func() {},
 			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
 		},
+		{
+			"order already reviewed",
+			"/v1/feedbacks",
+			http.MethodPost,
+			&request.CreateFeedbackRequest{
+				Description: "Good job!",
+				Rating:      5,
+				OrderID:     1,
+			},
+			http.StatusBadRequest,
+			func() {
+				s.usecase.EXPECT().CreateFeedback(gomock.Any(), gomock.Any(), gomock.Any()).Return(helper.NewAPIError(http.StatusBadRequest, "order already reviewed"))
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
 		{
 			"ok",
 			"/v1/feedbacks",
@@ -124,7 +139,7 @@ func (s *feedbackHandlerSuite) TestCreateFeedback() {
 			&request.CreateFeedbackRequest{
 				Description: "Good job!",
 				Rating:      5,
-				ToUserID:    1,
+				OrderID:     1,
 			},
 			http.StatusOK,
 			func() {
@@ -156,3 +171,100 @@ func (s *feedbackHandlerSuite) TestCreateFeedback() {
 		})
 	}
 }
+
+func (s *feedbackHandlerSuite) TestReplyFeedback() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/feedbacks",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+		{
+			"bad request",
+			"/v1/feedbacks",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "organizer"}),
+		},
+		{
+			"not found",
+			"/v1/feedbacks",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			request.ReplyFeedbackRequest{Reply: "Thank you for choosing us!"},
+			http.StatusNotFound,
+			func() {
+				apiError := helper.NewAPIError(http.StatusNotFound, "feedback not found")
+				s.usecase.EXPECT().ReplyFeedback(gomock.Any(), gomock.Any(), gomock.Eq("1"), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "organizer"}),
+		},
+		{
+			"ok",
+			"/v1/feedbacks",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			request.ReplyFeedbackRequest{Reply: "Thank you for choosing us!"},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().ReplyFeedback(gomock.Any(), gomock.Any(), gomock.Eq("1"), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "organizer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.ReplyFeedback(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
@@ -1,8 +1,9 @@
 package handler
 
 import (
-	"log"
+	"mime"
 	"net/http"
+	"path"
 
 	"github.com/andikabahari/eoplatform/helper"
 	"github.com/andikabahari/eoplatform/model"
@@ -77,8 +78,9 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 
 func (h *OrderHandler) AcceptOrCompleteOrder(c echo.Context) error {
 	order := model.Order{}
+	payment := model.Payment{}
 
-	if apiError := h.usecase.AcceptOrCompleteOrder(c, &order); apiError != nil {
+	if apiError := h.usecase.AcceptOrCompleteOrder(c, &order, &payment); apiError != nil {
 		code, message := apiError.APIError()
 		return c.JSON(code, echo.Map{
 			"message": "accept or complete order failure",
@@ -88,7 +90,78 @@ func (h *OrderHandler) AcceptOrCompleteOrder(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, echo.Map{
 		"message": "accept or complete order successful",
-		"data":    response.NewOrderResponse(order),
+		"data":    response.NewOrderWithPaymentResponse(order, payment),
+	})
+}
+
+func (h *OrderHandler) UploadReceipt(c echo.Context) error {
+	order := model.Order{}
+	payment := model.Payment{}
+
+	if apiError := h.usecase.UploadReceipt(c, &order, &payment); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "upload receipt failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "upload receipt successful",
+		"data":    response.NewOrderWithPaymentResponse(order, payment),
+	})
+}
+
+func (h *OrderHandler) GetReceipt(c echo.Context) error {
+	payment := model.Payment{}
+
+	receipt, apiError := h.usecase.GetReceipt(c, &payment)
+	if apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "fetch receipt failure",
+			"error":   message,
+		})
+	}
+	defer receipt.Close()
+
+	contentType := mime.TypeByExtension(path.Ext(payment.ReceiptKey))
+	if contentType == "" {
+		contentType = echo.MIMEOctetStream
+	}
+
+	c.Response().Header().Set("Cache-Control", "private, no-store")
+	return c.Stream(http.StatusOK, contentType, receipt)
+}
+
+func (h *OrderHandler) ConfirmOrRejectReceipt(c echo.Context) error {
+	req := request.ReviewReceiptRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	order := model.Order{}
+	payment := model.Payment{}
+
+	if apiError := h.usecase.ConfirmOrRejectReceipt(c, &order, &payment, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "confirm or reject receipt failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "confirm or reject receipt successful",
+		"data":    response.NewOrderWithPaymentResponse(order, payment),
 	})
 }
 
@@ -119,7 +192,6 @@ func (h *OrderHandler) PaymentStatus(c echo.Context) error {
 	if err := c.Bind(&req); err != nil {
 		return err
 	}
-	log.Println("Midtrans request:", req)
 
 	if apiError := h.usecase.PaymentStatus(&req); apiError != nil {
 		code, message := apiError.APIError()
@@ -133,3 +205,43 @@ func (h *OrderHandler) PaymentStatus(c echo.Context) error {
 		"message": "payment successful",
 	})
 }
+
+func (h *OrderHandler) GetWebhookNotifications(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	notifications := make([]model.WebhookNotification, 0)
+
+	if apiError := h.usecase.GetWebhookNotifications(claims, &notifications, c.QueryParam("status")); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "fetch webhook notifications failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch webhook notifications successful",
+		"data":    response.NewWebhookNotificationsResponse(notifications),
+	})
+}
+
+func (h *OrderHandler) ReplayWebhookNotification(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	notification := model.WebhookNotification{}
+
+	if apiError := h.usecase.ReplayWebhookNotification(claims, c, &notification); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "replay webhook notification failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "replay webhook notification successful",
+		"data":    response.NewWebhookNotificationResponse(notification),
+	})
+}
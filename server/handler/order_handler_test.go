@@ -3,18 +3,22 @@ package handler
 import (
 	"bytes"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
 	"github.com/andikabahari/eoplatform/request"
 	"github.com/andikabahari/eoplatform/server"
 	"github.com/andikabahari/eoplatform/testhelper"
 	mu "github.com/andikabahari/eoplatform/usecase/mock_usecase"
 	"github.com/golang-jwt/jwt"
 	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -173,6 +177,27 @@ func (s *orderHandlerSuite) TestCreateOrder() {
 			},
 			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
 		},
+		{
+			"ok with variant",
+			"/v1/orders",
+			nil,
+			http.MethodPost,
+			&request.CreateOrderRequest{
+				DateOfEvent: "2022-12-12",
+				FirstName:   "Example",
+				LastName:    "User",
+				Phone:       "08123456789",
+				Email:       "user@example.com",
+				Address:     "Mars",
+				Note:        "Ok.",
+				Services:    []request.OrderService{{ServiceID: 1, VariantID: new(uint)}},
+			},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().CreateOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -222,7 +247,7 @@ func (s *orderHandlerSuite) TestAcceptOrCompleteOrder() {
 			http.StatusNotFound,
 			func() {
 				apiError := helper.NewAPIError(http.StatusNotFound, "")
-				s.usecase.EXPECT().AcceptOrCompleteOrder(gomock.Any(), gomock.Any()).Return(apiError)
+				s.usecase.EXPECT().AcceptOrCompleteOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
 			},
 			nil,
 		},
@@ -234,7 +259,7 @@ func (s *orderHandlerSuite) TestAcceptOrCompleteOrder() {
 			nil,
 			http.StatusOK,
 			func() {
-				s.usecase.EXPECT().AcceptOrCompleteOrder(gomock.Any(), gomock.Any()).Return(nil)
+				s.usecase.EXPECT().AcceptOrCompleteOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
 		},
@@ -405,3 +430,386 @@ func (s *orderHandlerSuite) TestPaymentStatus() {
 		})
 	}
 }
+
+func (s *orderHandlerSuite) TestGetWebhookNotifications() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/webhook-notifications",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().GetWebhookNotifications(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/webhook-notifications",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetWebhookNotifications(gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, nil)
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+
+			s.NoError(s.handler.GetWebhookNotifications(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *orderHandlerSuite) TestReplayWebhookNotification() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/webhook-notifications/:id/replay",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().ReplayWebhookNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"not found",
+			"/v1/webhook-notifications/:id/replay",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusNotFound,
+			func() {
+				apiError := helper.NewAPIError(http.StatusNotFound, "")
+				s.usecase.EXPECT().ReplayWebhookNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+		{
+			"ok",
+			"/v1/webhook-notifications/:id/replay",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().ReplayWebhookNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, nil)
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.ReplayWebhookNotification(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *orderHandlerSuite) TestUploadReceipt() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"bad request",
+			"/v1/orders/:id/receipt",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusBadRequest,
+			func() {
+				apiError := helper.NewAPIError(http.StatusBadRequest, "")
+				s.usecase.EXPECT().UploadReceipt(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "customer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/orders/:id/receipt",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().UploadReceipt(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "customer",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, nil)
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.UploadReceipt(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *orderHandlerSuite) TestGetReceipt() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/orders/:id/receipt",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodGet,
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().GetReceipt(gomock.Any(), gomock.Any()).Return(nil, apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   3,
+				Role: "customer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/orders/:id/receipt",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodGet,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetReceipt(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx echo.Context, payment *model.Payment) (io.ReadCloser, helper.APIError) {
+					payment.ReceiptKey = "receipts/1-1.png"
+					return io.NopCloser(strings.NewReader("receipt")), nil
+				})
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "customer",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, nil)
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.GetReceipt(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+			if testCase.ExpectedCode == http.StatusOK {
+				s.Equal("image/png", rec.Header().Get(echo.HeaderContentType))
+				s.Equal("receipt", rec.Body.String())
+			}
+		})
+	}
+}
+
+func (s *orderHandlerSuite) TestConfirmOrRejectReceipt() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         *request.ReviewReceiptRequest
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"validation error",
+			"/v1/orders/:id/receipt/reject",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			&request.ReviewReceiptRequest{Note: strings.Repeat("a", 301)},
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"bad request",
+			"/v1/orders/:id/receipt/confirm",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			&request.ReviewReceiptRequest{},
+			http.StatusBadRequest,
+			func() {
+				apiError := helper.NewAPIError(http.StatusBadRequest, "")
+				s.usecase.EXPECT().ConfirmOrRejectReceipt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/orders/:id/receipt/confirm",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			&request.ReviewReceiptRequest{},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().ConfirmOrRejectReceipt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			ctx.SetPath(testCase.Endpoint)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.ConfirmOrRejectReceipt(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
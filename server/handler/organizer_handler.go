@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type OrganizerHandler struct {
+	usecase u.OrganizerUsecase
+}
+
+func NewOrganizerHandler(usecase u.OrganizerUsecase) *OrganizerHandler {
+	return &OrganizerHandler{usecase}
+}
+
+func (h *OrganizerHandler) FindOrganizer(c echo.Context) error {
+	organizer := model.Organizer{}
+
+	if apiError := h.usecase.FindOrganizer(&organizer, c.Param("id")); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "fetch organizer failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch organizer successful",
+		"data":    response.NewOrganizerResponse(organizer),
+	})
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/server"
+	"github.com/andikabahari/eoplatform/testhelper"
+	mu "github.com/andikabahari/eoplatform/usecase/mock_usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type organizerHandlerSuite struct {
+	suite.Suite
+
+	ctrl    *gomock.Controller
+	usecase *mu.MockOrganizerUsecase
+
+	server  *server.Server
+	handler *OrganizerHandler
+}
+
+func (s *organizerHandlerSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.usecase = mu.NewMockOrganizerUsecase(s.ctrl)
+
+	conn, _ := testhelper.Mock()
+	s.server = testhelper.NewServer(conn)
+	s.handler = NewOrganizerHandler(s.usecase)
+}
+
+func (s *organizerHandlerSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestOrganizerHandlerSuite(t *testing.T) {
+	suite.Run(t, new(organizerHandlerSuite))
+}
+
+func (s *organizerHandlerSuite) TestFindOrganizer() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"not found",
+			"/v1/organizers",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodGet,
+			nil,
+			http.StatusNotFound,
+			func() {
+				apiError := helper.NewAPIError(http.StatusNotFound, "")
+				s.usecase.EXPECT().FindOrganizer(gomock.Any(), gomock.Eq("1")).Return(apiError)
+			},
+			nil,
+		},
+		{
+			"ok",
+			"/v1/organizers",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().FindOrganizer(gomock.Any(), gomock.Eq("1")).Return(nil)
+			},
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.FindOrganizer(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+type PayoutHandler struct {
+	usecase u.PayoutUsecase
+}
+
+func NewPayoutHandler(usecase u.PayoutUsecase) *PayoutHandler {
+	return &PayoutHandler{usecase}
+}
+
+func (h *PayoutHandler) GetPayouts(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" && claims.Role != "admin" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "fetch payouts failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	payouts := make([]model.Payout, 0)
+	h.usecase.GetPayouts(claims, &payouts, c.QueryParam("status"))
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch payouts successful",
+		"data":    response.NewPayoutsResponse(payouts),
+	})
+}
+
+func (h *PayoutHandler) CreatePayout(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "create payout failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.CreatePayoutRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	payout := model.Payout{}
+
+	if apiError := h.usecase.CreatePayout(claims, &payout, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "create payout failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "create payout successful",
+		"data":    response.NewPayoutResponse(payout),
+	})
+}
+
+func (h *PayoutHandler) CompleteOrRejectPayout(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	payout := model.Payout{}
+
+	if apiError := h.usecase.CompleteOrRejectPayout(claims, c, &payout); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "complete or reject payout failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "complete or reject payout successful",
+		"data":    response.NewPayoutResponse(payout),
+	})
+}
@@ -0,0 +1,289 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/server"
+	"github.com/andikabahari/eoplatform/testhelper"
+	mu "github.com/andikabahari/eoplatform/usecase/mock_usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type payoutHandlerSuite struct {
+	suite.Suite
+
+	ctrl    *gomock.Controller
+	usecase *mu.MockPayoutUsecase
+
+	server  *server.Server
+	handler *PayoutHandler
+}
+
+func (s *payoutHandlerSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.usecase = mu.NewMockPayoutUsecase(s.ctrl)
+
+	conn, _ := testhelper.Mock()
+	s.server = testhelper.NewServer(conn)
+	s.handler = NewPayoutHandler(s.usecase)
+}
+
+func TestPayoutHandlerSuite(t *testing.T) {
+	suite.Run(t, new(payoutHandlerSuite))
+}
+
+func (s *payoutHandlerSuite) TestGetPayouts() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/payouts",
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "customer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/payouts",
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetPayouts(gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+
+			s.NoError(s.handler.GetPayouts(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *payoutHandlerSuite) TestCreatePayout() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		Method       string
+		Body         *request.CreatePayoutRequest
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/payouts",
+			http.MethodPost,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "customer",
+			}),
+		},
+		{
+			"bad request",
+			"/v1/payouts",
+			http.MethodPost,
+			&request.CreatePayoutRequest{},
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"bad request",
+			"/v1/payouts",
+			http.MethodPost,
+			&request.CreatePayoutRequest{Amount: 1000},
+			http.StatusBadRequest,
+			func() {
+				apiError := helper.NewAPIError(http.StatusBadRequest, "")
+				s.usecase.EXPECT().CreatePayout(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"ok",
+			"/v1/payouts",
+			http.MethodPost,
+			&request.CreatePayoutRequest{Amount: 1000},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().CreatePayout(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+
+			s.NoError(s.handler.CreatePayout(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *payoutHandlerSuite) TestCompleteOrRejectPayout() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/payouts/:id/complete",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().CompleteOrRejectPayout(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"not found",
+			"/v1/payouts/:id/complete",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusNotFound,
+			func() {
+				apiError := helper.NewAPIError(http.StatusNotFound, "")
+				s.usecase.EXPECT().CompleteOrRejectPayout(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+		{
+			"ok",
+			"/v1/payouts/:id/complete",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().CompleteOrRejectPayout(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.CompleteOrRejectPayout(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type RelatedServiceHandler struct {
+	usecase u.RelatedServiceUsecase
+}
+
+func NewRelatedServiceHandler(usecase u.RelatedServiceUsecase) *RelatedServiceHandler {
+	return &RelatedServiceHandler{usecase}
+}
+
+func (h *RelatedServiceHandler) GetRelatedServices(c echo.Context) error {
+	related := model.RelatedServices{}
+
+	if apiError := h.usecase.GetRelatedServices(&related, c.Param("id")); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "fetch related services failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch related services successful",
+		"data":    response.NewRelatedServicesResponse(related),
+	})
+}
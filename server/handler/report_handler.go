@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+type ReportHandler struct {
+	usecase u.ReportUsecase
+}
+
+func NewReportHandler(usecase u.ReportUsecase) *ReportHandler {
+	return &ReportHandler{usecase}
+}
+
+func (h *ReportHandler) GetRevenue(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	revenues := make([]model.Revenue, 0)
+
+	if apiError := h.usecase.GetRevenue(claims, &revenues, c.QueryParam("from"), c.QueryParam("to")); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "fetch revenue report failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch revenue report successful",
+		"data":    response.NewRevenueResponse(revenues),
+	})
+}
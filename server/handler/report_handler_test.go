@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/server"
+	"github.com/andikabahari/eoplatform/testhelper"
+	mu "github.com/andikabahari/eoplatform/usecase/mock_usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type reportHandlerSuite struct {
+	suite.Suite
+
+	ctrl    *gomock.Controller
+	usecase *mu.MockReportUsecase
+
+	server  *server.Server
+	handler *ReportHandler
+}
+
+func (s *reportHandlerSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.usecase = mu.NewMockReportUsecase(s.ctrl)
+
+	conn, _ := testhelper.Mock()
+	s.server = testhelper.NewServer(conn)
+	s.handler = NewReportHandler(s.usecase)
+}
+
+func TestReportHandlerSuite(t *testing.T) {
+	suite.Run(t, new(reportHandlerSuite))
+}
+
+func (s *reportHandlerSuite) TestGetRevenue() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/reports/revenue",
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {
+				apiError := helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+				s.usecase.EXPECT().GetRevenue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"bad request",
+			"/v1/reports/revenue?from=yesterday",
+			http.MethodGet,
+			nil,
+			http.StatusBadRequest,
+			func() {
+				apiError := helper.NewAPIError(http.StatusBadRequest, "")
+				s.usecase.EXPECT().GetRevenue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+		{
+			"ok",
+			"/v1/reports/revenue",
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetRevenue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   2,
+				Role: "admin",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+
+			s.NoError(s.handler.GetRevenue(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
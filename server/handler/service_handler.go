@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/andikabahari/eoplatform/helper"
@@ -21,12 +22,180 @@ func NewServiceHandler(usecase u.ServiceUsecase) *ServiceHandler {
 }
 
 func (h *ServiceHandler) GetServices(c echo.Context) error {
+	req := request.GetServicesRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
 	services := make([]model.Service, 0)
-	h.usecase.GetServices(&services, c.QueryParam("q"))
+	facets := model.ServiceFacets{}
+
+	if apiError := h.usecase.GetServices(&services, &facets, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "fetch services failure",
+			"error":   message,
+		})
+	}
 
 	return c.JSON(http.StatusOK, echo.Map{
 		"message": "fetch services successful",
 		"data":    response.NewServicesResponse(services),
+		"meta":    response.NewPaginationResponse(req.Page, req.Limit, facets.Total),
+		"facets":  response.NewServiceFacetsResponse(facets),
+	})
+}
+
+func (h *ServiceHandler) GetOwnServices(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "fetch services failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.GetOwnServicesRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	services := make([]model.Service, 0)
+	h.usecase.GetOwnServices(claims, &services, &req)
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch services successful",
+		"data":    response.NewServicesResponse(services),
+	})
+}
+
+func (h *ServiceHandler) ImportServices(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "import services failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.ImportServicesRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	result := model.ServiceImport{}
+
+	if apiError := h.usecase.ImportServices(c, &result, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "import services failure",
+			"error":   message,
+		})
+	}
+
+	if !result.DryRun && !result.Valid() {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   response.NewServiceImportErrorsResponse(result),
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "import services successful",
+		"data":    response.NewServiceImportResponse(result),
+	})
+}
+
+func (h *ServiceHandler) ExportServices(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "export services failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.ExportServicesRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	if req.Format == "" {
+		req.Format = request.ServiceImportFormatCSV
+	}
+
+	services := make([]model.Service, 0)
+	h.usecase.GetOwnServices(claims, &services, &request.GetOwnServicesRequest{Status: req.Status})
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"services.%s\"", req.Format))
+
+	if req.Format == request.ServiceImportFormatJSON {
+		return c.JSON(http.StatusOK, response.NewServiceExportResponse(services))
+	}
+
+	data, err := response.NewServiceExportCSV(services)
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, "text/csv; charset=utf-8", data)
+}
+
+func (h *ServiceHandler) GetRevisions(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "fetch service revisions failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	revisions := make([]model.ServiceRevision, 0)
+
+	if apiError := h.usecase.GetRevisions(claims, &revisions, c.Param("id")); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "fetch service revisions failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch service revisions successful",
+		"data":    response.NewServiceRevisionsResponse(revisions),
 	})
 }
 
@@ -72,7 +241,14 @@ func (h *ServiceHandler) CreateService(c echo.Context) error {
 	}
 
 	service := model.Service{}
-	h.usecase.CreateService(claims, &service, &req)
+
+	if apiError := h.usecase.CreateService(claims, &service, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "create service failure",
+			"error":   message,
+		})
+	}
 
 	return c.JSON(http.StatusOK, echo.Map{
 		"message": "create service successful",
@@ -124,9 +300,10 @@ func (h *ServiceHandler) DeleteService(c echo.Context) error {
 	return c.JSON(http.StatusOK, echo.Map{
 		"message": "delete service successful",
 		"data": echo.Map{
-			"kind":    "service",
-			"id":      c.Param("id"),
-			"deleted": true,
+			"kind":     "service",
+			"id":       c.Param("id"),
+			"deleted":  true,
+			"archived": service.Status == model.ServiceStatusArchived,
 		},
 	})
 }
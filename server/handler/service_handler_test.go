@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
 	"github.com/andikabahari/eoplatform/request"
 	"github.com/andikabahari/eoplatform/server"
 	"github.com/andikabahari/eoplatform/testhelper"
@@ -16,6 +17,7 @@ import (
 	"github.com/golang-jwt/jwt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 )
 
 type serviceHandlerSuite struct {
@@ -62,7 +64,71 @@ func (s *serviceHandlerSuite) TestGetServices() {
 			nil,
 			http.StatusOK,
 			func() {
-				s.usecase.EXPECT().GetServices(gomock.Any(), gomock.Any())
+				s.usecase.EXPECT().GetServices(gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			nil,
+		},
+		{
+			"ok",
+			"/v1/services?q=wedding&category=venue&tag=outdoor",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetServices(
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Eq(&request.GetServicesRequest{Keyword: "wedding", Category: "venue", Tag: "outdoor"}),
+				)
+			},
+			nil,
+		},
+		{
+			"ok",
+			"/v1/services?min_price=100000&max_price=500000&organizer=2&min_rating=4&available_on=2024-05-01&sort=price_asc&page=2&limit=10",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetServices(
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Eq(&request.GetServicesRequest{
+						MinPrice:    100000,
+						MaxPrice:    500000,
+						Organizer:   2,
+						MinRating:   4,
+						AvailableOn: "2024-05-01",
+						Sort:        "price_asc",
+						Page:        2,
+						Limit:       10,
+					}),
+				)
+			},
+			nil,
+		},
+		{
+			"validation error",
+			"/v1/services?sort=cheapest&limit=1000",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusBadRequest,
+			func() {},
+			nil,
+		},
+		{
+			"bad request",
+			"/v1/services?min_price=500000&max_price=100000",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusBadRequest,
+			func() {
+				apiError := helper.NewAPIError(http.StatusBadRequest, "")
+				s.usecase.EXPECT().GetServices(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
 			},
 			nil,
 		},
@@ -95,6 +161,351 @@ func (s *serviceHandlerSuite) TestGetServices() {
 	}
 }
 
+func (s *serviceHandlerSuite) TestGetOwnServices() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/account/services",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+		{
+			"bad request",
+			"/v1/account/services?status=deleted",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"ok",
+			"/v1/account/services?status=draft",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetOwnServices(gomock.Any(), gomock.Any(), gomock.Eq(&request.GetOwnServicesRequest{Status: "draft"}))
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.GetOwnServices(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *serviceHandlerSuite) TestImportServices() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/account/services/import",
+			nil,
+			http.MethodPost,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+		{
+			"bad file",
+			"/v1/account/services/import",
+			nil,
+			http.MethodPost,
+			nil,
+			http.StatusBadRequest,
+			func() {
+				apiError := helper.NewAPIError(http.StatusBadRequest, "")
+				s.usecase.EXPECT().ImportServices(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"invalid rows",
+			"/v1/account/services/import",
+			nil,
+			http.MethodPost,
+			nil,
+			http.StatusBadRequest,
+			func() {
+				s.usecase.EXPECT().ImportServices(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(1, model.ServiceImport{
+					Rows: []model.ServiceImportRow{{Row: 1, Errors: map[string]string{"name": "cannot be blank"}}},
+				}).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"dry run with invalid rows",
+			"/v1/account/services/import",
+			nil,
+			http.MethodPost,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().ImportServices(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(1, model.ServiceImport{
+					DryRun: true,
+					Rows:   []model.ServiceImportRow{{Row: 1, Errors: map[string]string{"name": "cannot be blank"}}},
+				}).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"ok",
+			"/v1/account/services/import",
+			nil,
+			http.MethodPost,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().ImportServices(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.ImportServices(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *serviceHandlerSuite) TestExportServices() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/account/services/export",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+		{
+			"bad request",
+			"/v1/account/services/export?format=xlsx",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"ok csv",
+			"/v1/account/services/export",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetOwnServices(gomock.Any(), gomock.Any(), gomock.Eq(&request.GetOwnServicesRequest{})).
+					SetArg(1, []model.Service{{Model: gorm.Model{ID: 1}, Name: "Venue", Tags: []model.Tag{{Name: "outdoor"}}}})
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"ok json",
+			"/v1/account/services/export?format=json&status=published",
+			nil,
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetOwnServices(gomock.Any(), gomock.Any(), gomock.Eq(&request.GetOwnServicesRequest{Status: "published"}))
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.ExportServices(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *serviceHandlerSuite) TestGetRevisions() {
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/services",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodGet,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "customer"}),
+		},
+		{
+			"not found",
+			"/v1/services",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodGet,
+			nil,
+			http.StatusNotFound,
+			func() {
+				apiError := helper.NewAPIError(http.StatusNotFound, "")
+				s.usecase.EXPECT().GetRevisions(gomock.Any(), gomock.Any(), gomock.Eq("1")).Return(apiError)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+		{
+			"ok",
+			"/v1/services",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodGet,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().GetRevisions(gomock.Any(), gomock.Any(), gomock.Eq("1")).Return(nil)
+			},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.GetRevisions(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
 func (s *serviceHandlerSuite) TestFindService() {
 	testCases := []struct {
 		Name         string
@@ -200,6 +611,71 @@ func (s *serviceHandlerSuite) TestCreateService() {
 				Role: "organizer",
 			}),
 		},
+		{
+			"negative cost",
+			"/v1/services",
+			nil,
+			http.MethodPost,
+			&request.CreateServiceRequest{
+				BasicService: request.BasicService{
+					Name:        "Service",
+					Cost:        -1000000,
+					Phone:       "08123456789",
+					Email:       "user@example.com",
+					Description: "Lorem ipsum",
+				},
+			},
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"negative variant price",
+			"/v1/services",
+			nil,
+			http.MethodPost,
+			&request.CreateServiceRequest{
+				BasicService: request.BasicService{
+					Name:        "Service",
+					Cost:        1000000,
+					Phone:       "08123456789",
+					Email:       "user@example.com",
+					Description: "Lorem ipsum",
+					Variants:    []request.Variant{{Name: "Gold", Price: -1}},
+				},
+			},
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
+		{
+			"negative add-on price",
+			"/v1/services",
+			nil,
+			http.MethodPost,
+			&request.CreateServiceRequest{
+				BasicService: request.BasicService{
+					Name:        "Service",
+					Cost:        1000000,
+					Phone:       "08123456789",
+					Email:       "user@example.com",
+					Description: "Lorem ipsum",
+					Addons:      []request.Addon{{Name: "Extra hour", Price: -1, MaxQuantity: 1}},
+				},
+			},
+			http.StatusBadRequest,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{
+				ID:   1,
+				Role: "organizer",
+			}),
+		},
 		{
 			"ok",
 			"/v1/services",
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+type ServiceImageHandler struct {
+	usecase u.ServiceImageUsecase
+}
+
+func NewServiceImageHandler(usecase u.ServiceImageUsecase) *ServiceImageHandler {
+	return &ServiceImageHandler{usecase}
+}
+
+func (h *ServiceImageHandler) UploadServiceImage(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "upload service image failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	image := model.ServiceImage{}
+
+	if apiError := h.usecase.UploadServiceImage(c, &image); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "upload service image failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "upload service image successful",
+		"data":    response.NewServiceImageResponse(image),
+	})
+}
+
+func (h *ServiceImageHandler) UpdateServiceImage(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "update service image failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.UpdateServiceImageRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	image := model.ServiceImage{}
+
+	if apiError := h.usecase.UpdateServiceImage(c, &image, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "update service image failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "update service image successful",
+		"data":    response.NewServiceImageResponse(image),
+	})
+}
+
+func (h *ServiceImageHandler) ReorderServiceImages(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "reorder service images failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.ReorderServiceImagesRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	images := make([]model.ServiceImage, 0)
+
+	if apiError := h.usecase.ReorderServiceImages(c, &images, &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "reorder service images failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "reorder service images successful",
+		"data":    response.NewServiceImagesResponse(images),
+	})
+}
+
+func (h *ServiceImageHandler) DeleteServiceImage(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "delete service image failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	image := model.ServiceImage{}
+
+	if apiError := h.usecase.DeleteServiceImage(c, &image); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "delete service image failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "delete service image successful",
+		"data": echo.Map{
+			"kind":    "service_image",
+			"id":      c.Param("imageId"),
+			"deleted": true,
+		},
+	})
+}
@@ -0,0 +1,331 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/server"
+	"github.com/andikabahari/eoplatform/testhelper"
+	mu "github.com/andikabahari/eoplatform/usecase/mock_usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type serviceImageHandlerSuite struct {
+	suite.Suite
+
+	ctrl    *gomock.Controller
+	usecase *mu.MockServiceImageUsecase
+
+	server  *server.Server
+	handler *ServiceImageHandler
+}
+
+func (s *serviceImageHandlerSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.usecase = mu.NewMockServiceImageUsecase(s.ctrl)
+
+	conn, _ := testhelper.Mock()
+	s.server = testhelper.NewServer(conn)
+	s.handler = NewServiceImageHandler(s.usecase)
+}
+
+func TestServiceImageHandlerSuite(t *testing.T) {
+	suite.Run(t, new(serviceImageHandlerSuite))
+}
+
+func (s *serviceImageHandlerSuite) TestUploadServiceImage() {
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/services/:id/images",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "customer"}),
+		},
+		{
+			"bad request",
+			"/v1/services/:id/images",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusBadRequest,
+			func() {
+				apiError := helper.NewAPIError(http.StatusBadRequest, "")
+				s.usecase.EXPECT().UploadServiceImage(gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			organizer,
+		},
+		{
+			"ok",
+			"/v1/services/:id/images",
+			&testhelper.PathParam{
+				Names:  []string{"id"},
+				Values: []string{"1"},
+			},
+			http.MethodPost,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().UploadServiceImage(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			organizer,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, nil)
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.UploadServiceImage(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *serviceImageHandlerSuite) TestUpdateServiceImage() {
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+	pathParam := &testhelper.PathParam{
+		Names:  []string{"id", "imageId"},
+		Values: []string{"1", "1"},
+	}
+
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"validation error",
+			"/v1/services/:id/images/:imageId",
+			pathParam,
+			http.MethodPut,
+			&request.UpdateServiceImageRequest{Caption: string(make([]byte, 201))},
+			http.StatusBadRequest,
+			func() {},
+			organizer,
+		},
+		{
+			"not found",
+			"/v1/services/:id/images/:imageId",
+			pathParam,
+			http.MethodPut,
+			&request.UpdateServiceImageRequest{Caption: "Stage"},
+			http.StatusNotFound,
+			func() {
+				apiError := helper.NewAPIError(http.StatusNotFound, "")
+				s.usecase.EXPECT().UpdateServiceImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(apiError)
+			},
+			organizer,
+		},
+		{
+			"ok",
+			"/v1/services/:id/images/:imageId",
+			pathParam,
+			http.MethodPut,
+			&request.UpdateServiceImageRequest{Caption: "Stage", IsCover: true},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().UpdateServiceImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			organizer,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.UpdateServiceImage(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *serviceImageHandlerSuite) TestReorderServiceImages() {
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+	pathParam := &testhelper.PathParam{
+		Names:  []string{"id"},
+		Values: []string{"1"},
+	}
+
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"validation error",
+			"/v1/services/:id/images",
+			pathParam,
+			http.MethodPut,
+			&request.ReorderServiceImagesRequest{},
+			http.StatusBadRequest,
+			func() {},
+			organizer,
+		},
+		{
+			"ok",
+			"/v1/services/:id/images",
+			pathParam,
+			http.MethodPut,
+			&request.ReorderServiceImagesRequest{ImageIDs: []uint{2, 1}},
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().ReorderServiceImages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			organizer,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			bodyReader := new(bytes.Reader)
+			if testCase.Body != nil {
+				body, err := json.Marshal(testCase.Body)
+				s.NoError(err)
+				bodyReader = bytes.NewReader(body)
+			}
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, bodyReader)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.ReorderServiceImages(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
+
+func (s *serviceImageHandlerSuite) TestDeleteServiceImage() {
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+	pathParam := &testhelper.PathParam{
+		Names:  []string{"id", "imageId"},
+		Values: []string{"1", "1"},
+	}
+
+	testCases := []struct {
+		Name         string
+		Endpoint     string
+		PathParam    *testhelper.PathParam
+		Method       string
+		Body         any
+		ExpectedCode int
+		ExpectedFunc func()
+		Token        *jwt.Token
+	}{
+		{
+			"unauthorized",
+			"/v1/services/:id/images/:imageId",
+			pathParam,
+			http.MethodDelete,
+			nil,
+			http.StatusUnauthorized,
+			func() {},
+			jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "customer"}),
+		},
+		{
+			"ok",
+			"/v1/services/:id/images/:imageId",
+			pathParam,
+			http.MethodDelete,
+			nil,
+			http.StatusOK,
+			func() {
+				s.usecase.EXPECT().DeleteServiceImage(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			organizer,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			req := httptest.NewRequest(testCase.Method, testCase.Endpoint, nil)
+			rec := httptest.NewRecorder()
+			ctx := s.server.Echo.NewContext(req, rec)
+			ctx.Set("user", testCase.Token)
+			if testCase.PathParam != nil {
+				ctx.SetParamNames(testCase.PathParam.Names...)
+				ctx.SetParamValues(testCase.PathParam.Values...)
+			}
+
+			s.NoError(s.handler.DeleteServiceImage(ctx))
+			s.Equal(testCase.ExpectedCode, rec.Code)
+		})
+	}
+}
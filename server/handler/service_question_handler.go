@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/response"
+	u "github.com/andikabahari/eoplatform/usecase"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+type ServiceQuestionHandler struct {
+	usecase u.ServiceQuestionUsecase
+}
+
+func NewServiceQuestionHandler(usecase u.ServiceQuestionUsecase) *ServiceQuestionHandler {
+	return &ServiceQuestionHandler{usecase}
+}
+
+func (h *ServiceQuestionHandler) GetQuestions(c echo.Context) error {
+	questions := make([]model.ServiceQuestion, 0)
+
+	if apiError := h.usecase.GetQuestions(&questions, c.Param("id")); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "fetch questions failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "fetch questions successful",
+		"data":    response.NewServiceQuestionsResponse(questions),
+	})
+}
+
+func (h *ServiceQuestionHandler) AskQuestion(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "customer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "ask question failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.CreateServiceQuestionRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	question := model.ServiceQuestion{}
+
+	if apiError := h.usecase.AskQuestion(claims, &question, c.Param("id"), &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "ask question failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "ask question successful",
+		"data":    response.NewServiceQuestionResponse(question),
+	})
+}
+
+func (h *ServiceQuestionHandler) AnswerQuestion(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if claims.Role != "organizer" {
+		return c.JSON(http.StatusUnauthorized, echo.Map{
+			"message": "answer question failure",
+			"error":   "unauthorized",
+		})
+	}
+
+	req := request.AnswerServiceQuestionRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	question := model.ServiceQuestion{}
+
+	if apiError := h.usecase.AnswerQuestion(claims, &question, c.Param("id"), c.Param("questionId"), &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "answer question failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "answer question successful",
+		"data":    response.NewServiceQuestionResponse(question),
+	})
+}
+
+func (h *ServiceQuestionHandler) ModerateQuestion(c echo.Context) error {
+	userToken := c.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	req := request.ModerateServiceQuestionRequest{}
+
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, echo.Map{
+			"message": "validation error",
+			"error":   err,
+		})
+	}
+
+	question := model.ServiceQuestion{}
+
+	if apiError := h.usecase.ModerateQuestion(claims, &question, c.Param("id"), c.Param("questionId"), &req); apiError != nil {
+		code, message := apiError.APIError()
+		return c.JSON(code, echo.Map{
+			"message": "moderate question failure",
+			"error":   message,
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "moderate question successful",
+		"data":    response.NewServiceQuestionResponse(question),
+	})
+}
@@ -1,25 +1,58 @@
 package route
 
 import (
+	"net/url"
+	"time"
+
 	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/moderation"
 	"github.com/andikabahari/eoplatform/repository"
+	"github.com/andikabahari/eoplatform/search"
+	"github.com/andikabahari/eoplatform/sentiment"
 	s "github.com/andikabahari/eoplatform/server"
 	"github.com/andikabahari/eoplatform/server/handler"
+	"github.com/andikabahari/eoplatform/storage"
 	"github.com/andikabahari/eoplatform/usecase"
 	"github.com/labstack/echo/v4/middleware"
 )
 
 func Setup(server *s.Server) {
+	transactor := repository.NewTransactor(server.DB)
 	userRepository := repository.NewUserRepository(server.DB)
 	bankAccountRepository := repository.NewBankAccountRepository(server.DB)
 	serviceRepository := repository.NewServiceRepository(server.DB)
 	orderRepository := repository.NewOrderRepository(server.DB)
 	paymentRepository := repository.NewPaymentRepository(server.DB)
 	feedbackRepository := repository.NewFeedbackRepository(server.DB)
+	ledgerRepository := repository.NewLedgerRepository(server.DB)
+	payoutRepository := repository.NewPayoutRepository(server.DB)
+	commissionRateRepository := repository.NewCommissionRateRepository(server.DB)
+	webhookNotificationRepository := repository.NewWebhookNotificationRepository(server.DB)
+	categoryRepository := repository.NewCategoryRepository(server.DB)
+	tagRepository := repository.NewTagRepository(server.DB)
+	serviceImageRepository := repository.NewServiceImageRepository(server.DB)
+	organizerProfileRepository := repository.NewOrganizerProfileRepository(server.DB)
+	ratingAggregateRepository := repository.NewRatingAggregateRepository(server.DB)
+	favoriteRepository := repository.NewFavoriteRepository(server.DB)
+	serviceQuestionRepository := repository.NewServiceQuestionRepository(server.DB)
+	serviceRevisionRepository := repository.NewServiceRevisionRepository(server.DB)
+	serviceCooccurrenceRepository := repository.NewServiceCooccurrenceRepository(server.DB)
+
+	fileStorage := storage.New(server.Config.Storage)
+	receiptStorage := storage.NewPrivate(server.Config.Storage)
+	searchIndex := search.NewMemoryIndex()
+	moderator := moderation.NewKeywordModerator(server.Config.Moderation.BlockedWords)
+	sentimentAnalyzer := sentiment.New(server.Config.Sentiment)
 
 	server.Echo.Use(middleware.Recover())
 	server.Echo.Use(middleware.Logger())
 
+	if server.Config.Storage.Driver == "local" {
+		if baseURL, err := url.Parse(server.Config.Storage.BaseURL); err == nil && baseURL.Path != "" {
+			server.Echo.Static(baseURL.Path, server.Config.Storage.LocalDir)
+		}
+	}
+
 	auth := middleware.JWTWithConfig(middleware.JWTConfig{
 		Claims:     &helper.JWTCustomClaims{},
 		SigningKey: []byte(server.Config.Auth.Secret),
@@ -39,28 +72,81 @@ func Setup(server *s.Server) {
 	v1.POST("/login", loginHandler.Login)
 
 	accountV1 := v1.Group("/account")
-	accountUsecase := usecase.NewAccountUsecase(userRepository)
+	accountUsecase := usecase.NewAccountUsecase(userRepository, organizerProfileRepository)
 	accountHandler := handler.NewAccountHandler(accountUsecase)
 	accountV1.GET("", accountHandler.GetAccount, auth)
 	accountV1.PUT("", accountHandler.UpdateAccount, auth)
 	accountV1.PUT("/password", accountHandler.ResetPassword, auth)
+	accountV1.GET("/profile", accountHandler.GetProfile, auth)
+	accountV1.PUT("/profile", accountHandler.UpdateProfile, auth)
 
 	serviceV1 := v1.Group("/services")
-	serviceUsecase := usecase.NewServiceUsecase(serviceRepository)
+	serviceUsecase := usecase.NewServiceUsecase(transactor, serviceRepository, categoryRepository, tagRepository, favoriteRepository, serviceRevisionRepository, searchIndex)
+	go rebuildSearchIndex(serviceUsecase, server.Config.Search.RebuildInterval)
 	serviceHandler := handler.NewServiceHandler(serviceUsecase)
 	serviceV1.GET("", serviceHandler.GetServices)
 	serviceV1.GET("/:id", serviceHandler.FindService)
 	serviceV1.POST("", serviceHandler.CreateService, auth)
 	serviceV1.PUT("/:id", serviceHandler.UpdateService, auth)
 	serviceV1.DELETE("/:id", serviceHandler.DeleteService, auth)
+	serviceV1.GET("/:id/revisions", serviceHandler.GetRevisions, auth)
+	accountV1.GET("/services", serviceHandler.GetOwnServices, auth)
+	accountV1.POST("/services/import", serviceHandler.ImportServices, auth)
+	accountV1.GET("/services/export", serviceHandler.ExportServices, auth)
+
+	relatedServiceUsecase := usecase.NewRelatedServiceUsecase(serviceRepository, serviceCooccurrenceRepository, searchIndex)
+	go refreshCooccurrences(relatedServiceUsecase, server.Config.Recommendation.RefreshInterval)
+	relatedServiceHandler := handler.NewRelatedServiceHandler(relatedServiceUsecase)
+	serviceV1.GET("/:id/related", relatedServiceHandler.GetRelatedServices)
+
+	serviceImageUsecase := usecase.NewServiceImageUsecase(serviceRepository, serviceImageRepository, fileStorage)
+	serviceImageHandler := handler.NewServiceImageHandler(serviceImageUsecase)
+	serviceV1.POST("/:id/images", serviceImageHandler.UploadServiceImage, auth)
+	serviceV1.PUT("/:id/images", serviceImageHandler.ReorderServiceImages, auth)
+	serviceV1.PUT("/:id/images/:imageId", serviceImageHandler.UpdateServiceImage, auth)
+	serviceV1.DELETE("/:id/images/:imageId", serviceImageHandler.DeleteServiceImage, auth)
+
+	serviceQuestionUsecase := usecase.NewServiceQuestionUsecase(serviceRepository, serviceQuestionRepository, userRepository, moderator)
+	serviceQuestionHandler := handler.NewServiceQuestionHandler(serviceQuestionUsecase)
+	serviceV1.GET("/:id/questions", serviceQuestionHandler.GetQuestions)
+	serviceV1.POST("/:id/questions", serviceQuestionHandler.AskQuestion, auth)
+	serviceV1.PUT("/:id/questions/:questionId/answer", serviceQuestionHandler.AnswerQuestion, auth)
+	serviceV1.PUT("/:id/questions/:questionId/status", serviceQuestionHandler.ModerateQuestion, auth)
+
+	favoriteV1 := v1.Group("/favorites")
+	favoriteUsecase := usecase.NewFavoriteUsecase(favoriteRepository, serviceRepository)
+	favoriteHandler := handler.NewFavoriteHandler(favoriteUsecase)
+	favoriteV1.GET("", favoriteHandler.GetFavorites, auth)
+	favoriteV1.POST("/:serviceId", favoriteHandler.AddFavorite, auth)
+	favoriteV1.DELETE("/:serviceId", favoriteHandler.RemoveFavorite, auth)
+
+	organizerV1 := v1.Group("/organizers")
+	organizerUsecase := usecase.NewOrganizerUsecase(userRepository, organizerProfileRepository, serviceRepository, ratingAggregateRepository, orderRepository)
+	organizerHandler := handler.NewOrganizerHandler(organizerUsecase)
+	organizerV1.GET("/:id", organizerHandler.FindOrganizer)
+
+	categoryV1 := v1.Group("/categories")
+	categoryUsecase := usecase.NewCategoryUsecase(transactor, categoryRepository, serviceRepository, commissionRateRepository)
+	categoryHandler := handler.NewCategoryHandler(categoryUsecase)
+	categoryV1.GET("", categoryHandler.GetCategories)
+	categoryV1.POST("", categoryHandler.CreateCategory, auth)
+	categoryV1.PUT("/:id", categoryHandler.UpdateCategory, auth)
+	categoryV1.DELETE("/:id", categoryHandler.DeleteCategory, auth)
 
 	orderV1 := v1.Group("/orders")
 	orderUsecase := usecase.NewOrderUsecase(
+		transactor,
 		orderRepository,
 		paymentRepository,
 		userRepository,
 		serviceRepository,
+		serviceRevisionRepository,
+		ledgerRepository,
+		commissionRateRepository,
+		webhookNotificationRepository,
 		bankAccountRepository,
+		receiptStorage,
+		server.Config.Commission,
 	)
 	orderHandler := handler.NewOrderHandler(orderUsecase)
 	orderV1.GET("", orderHandler.GetOrders, auth)
@@ -68,8 +154,16 @@ func Setup(server *s.Server) {
 	orderV1.POST("/:id/accept", orderHandler.AcceptOrCompleteOrder, auth)
 	orderV1.POST("/:id/complete", orderHandler.AcceptOrCompleteOrder, auth)
 	orderV1.POST("/:id/cancel", orderHandler.CancelOrder, auth)
+	orderV1.GET("/:id/receipt", orderHandler.GetReceipt, auth)
+	orderV1.POST("/:id/receipt", orderHandler.UploadReceipt, auth)
+	orderV1.POST("/:id/receipt/confirm", orderHandler.ConfirmOrRejectReceipt, auth)
+	orderV1.POST("/:id/receipt/reject", orderHandler.ConfirmOrRejectReceipt, auth)
 	v1.POST("/MDDRlkYVFm9QOLK08MDp", orderHandler.PaymentStatus)
 
+	webhookNotificationV1 := v1.Group("/webhook-notifications")
+	webhookNotificationV1.GET("", orderHandler.GetWebhookNotifications, auth)
+	webhookNotificationV1.POST("/:id/replay", orderHandler.ReplayWebhookNotification, auth)
+
 	bankAccountV1 := v1.Group("/bank-accounts")
 	bankAccountUsecase := usecase.NewBankAccountUsecase(bankAccountRepository)
 	bankAccountHandler := handler.NewBankAccountHandler(bankAccountUsecase)
@@ -77,9 +171,71 @@ func Setup(server *s.Server) {
 	bankAccountV1.POST("", bankAccountHandler.CreateBankAccount, auth)
 	bankAccountV1.PUT("", bankAccountHandler.UpdateBankAccount, auth)
 
+	balanceV1 := v1.Group("/balance")
+	balanceUsecase := usecase.NewBalanceUsecase(ledgerRepository, paymentRepository)
+	balanceHandler := handler.NewBalanceHandler(balanceUsecase)
+	balanceV1.GET("", balanceHandler.GetBalance, auth)
+	balanceV1.GET("/transactions", balanceHandler.GetTransactions, auth)
+	balanceV1.GET("/earnings", balanceHandler.GetEarnings, auth)
+
+	payoutV1 := v1.Group("/payouts")
+	payoutUsecase := usecase.NewPayoutUsecase(transactor, payoutRepository, ledgerRepository, bankAccountRepository)
+	payoutHandler := handler.NewPayoutHandler(payoutUsecase)
+	payoutV1.GET("", payoutHandler.GetPayouts, auth)
+	payoutV1.POST("", payoutHandler.CreatePayout, auth)
+	payoutV1.POST("/:id/complete", payoutHandler.CompleteOrRejectPayout, auth)
+	payoutV1.POST("/:id/reject", payoutHandler.CompleteOrRejectPayout, auth)
+
+	commissionRateV1 := v1.Group("/commission-rates")
+	commissionRateUsecase := usecase.NewCommissionRateUsecase(commissionRateRepository, userRepository, categoryRepository)
+	commissionRateHandler := handler.NewCommissionRateHandler(commissionRateUsecase)
+	commissionRateV1.GET("", commissionRateHandler.GetCommissionRates, auth)
+	commissionRateV1.PUT("", commissionRateHandler.SetCommissionRate, auth)
+	commissionRateV1.DELETE("/:id", commissionRateHandler.DeleteCommissionRate, auth)
+
+	reportV1 := v1.Group("/reports")
+	reportUsecase := usecase.NewReportUsecase(paymentRepository, userRepository)
+	reportHandler := handler.NewReportHandler(reportUsecase)
+	reportV1.GET("/revenue", reportHandler.GetRevenue, auth)
+
 	feedbackV1 := v1.Group("/feedbacks")
-	feedbackUsecase := usecase.NewFeedbackUsecase(feedbackRepository, userRepository)
+	feedbackUsecase := usecase.NewFeedbackUsecase(feedbackRepository, userRepository, orderRepository, ratingAggregateRepository, sentimentAnalyzer)
+	go scoreFeedbacks(feedbackUsecase, server.Config.Sentiment.PollInterval)
 	feedbackHandler := handler.NewFeedbackHandler(feedbackUsecase)
 	feedbackV1.GET("", feedbackHandler.GetFeedbacks)
 	feedbackV1.POST("", feedbackHandler.CreateFeedback, auth)
+	feedbackV1.POST("/:id/reply", feedbackHandler.ReplyFeedback, auth)
+}
+
+// rebuildSearchIndex builds the in-memory search index and keeps rebuilding
+// it, so instances pick up services changed through other instances.
+func rebuildSearchIndex(serviceUsecase usecase.ServiceUsecase, interval time.Duration) {
+	for {
+		serviceUsecase.RebuildSearchIndex()
+		if interval == 0 {
+			return
+		}
+		time.Sleep(interval)
+	}
+}
+
+// refreshCooccurrences recounts the services booked together and keeps
+// recounting them as new orders come in.
+func refreshCooccurrences(relatedServiceUsecase usecase.RelatedServiceUsecase, interval time.Duration) {
+	for {
+		relatedServiceUsecase.RefreshCooccurrences()
+		if interval == 0 {
+			return
+		}
+		time.Sleep(interval)
+	}
+}
+
+// scoreFeedbacks keeps scoring the sentiment of new feedbacks and retrying
+// the ones that failed once their backoff is over.
+func scoreFeedbacks(feedbackUsecase usecase.FeedbackUsecase, interval time.Duration) {
+	for {
+		feedbackUsecase.ScorePendingFeedbacks()
+		time.Sleep(interval)
+	}
 }
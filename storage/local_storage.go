@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type localStorage struct {
+	dir     string
+	baseURL string
+}
+
+func NewLocalStorage(dir, baseURL string) Storage {
+	return &localStorage{dir, strings.TrimSuffix(baseURL, "/")}
+}
+
+func (s *localStorage) Put(key string, body io.Reader, contentType string) (string, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return "", err
+	}
+
+	file, err := os.Create(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, body); err != nil {
+		return "", err
+	}
+
+	return s.baseURL + "/" + key, nil
+}
+
+func (s *localStorage) Get(key string) (io.ReadCloser, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Open(name)
+}
+
+func (s *localStorage) Delete(key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(name)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+// path maps a key to a file inside the storage directory, rejecting keys
+// that would escape it.
+func (s *localStorage) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if clean == "/" || clean != "/"+key {
+		return "", os.ErrInvalid
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(clean)), nil
+}
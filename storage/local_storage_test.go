@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type localStorageSuite struct {
+	suite.Suite
+	dir     string
+	storage Storage
+}
+
+func (s *localStorageSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.storage = NewLocalStorage(s.dir, "/uploads/")
+}
+
+func TestLocalStorageSuite(t *testing.T) {
+	suite.Run(t, new(localStorageSuite))
+}
+
+func (s *localStorageSuite) TestPut() {
+	url, err := s.storage.Put("receipts/1.png", strings.NewReader("receipt"), "image/png")
+	s.NoError(err)
+	s.Equal("/uploads/receipts/1.png", url)
+
+	body, err := os.ReadFile(filepath.Join(s.dir, "receipts", "1.png"))
+	s.NoError(err)
+	s.Equal("receipt", string(body))
+}
+
+func (s *localStorageSuite) TestPutInvalidKey() {
+	for _, key := range []string{"", "../1.png", "receipts/../../1.png", "/1.png"} {
+		_, err := s.storage.Put(key, strings.NewReader("receipt"), "image/png")
+		s.Error(err, key)
+	}
+}
+
+func (s *localStorageSuite) TestGet() {
+	_, err := s.storage.Put("receipts/1.png", strings.NewReader("receipt"), "image/png")
+	s.NoError(err)
+
+	body, err := s.storage.Get("receipts/1.png")
+	s.NoError(err)
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	s.NoError(err)
+	s.Equal("receipt", string(content))
+
+	_, err = s.storage.Get("receipts/2.png")
+	s.ErrorIs(err, fs.ErrNotExist)
+}
+
+func (s *localStorageSuite) TestDelete() {
+	_, err := s.storage.Put("receipts/1.png", strings.NewReader("receipt"), "image/png")
+	s.NoError(err)
+
+	s.NoError(s.storage.Delete("receipts/1.png"))
+	s.NoError(s.storage.Delete("receipts/1.png"))
+
+	_, err = os.Stat(filepath.Join(s.dir, "receipts", "1.png"))
+	s.True(os.IsNotExist(err))
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"io"
+
+	"github.com/andikabahari/eoplatform/config"
+)
+
+// Storage keeps uploaded files under a key and tells where they can be
+// downloaded from. Get fails with an error wrapping fs.ErrNotExist when
+// nothing is stored under the key.
+type Storage interface {
+	Put(key string, body io.Reader, contentType string) (string, error)
+	Get(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
+
+func New(config config.StorageConfig) Storage {
+	if config.Driver == "s3" {
+		return NewS3Storage(
+			config.S3Endpoint,
+			config.S3Region,
+			config.S3Bucket,
+			config.S3AccessKey,
+			config.S3SecretKey,
+			config.S3PublicURL,
+		)
+	}
+
+	return NewLocalStorage(config.LocalDir, config.BaseURL)
+}
+
+// NewPrivate returns the storage for files that must only be served through
+// an authorized endpoint, such as payment receipts. Its files are never
+// served publicly: it uses the private bucket, or the private local
+// directory when no private bucket is set.
+func NewPrivate(config config.StorageConfig) Storage {
+	if config.Driver == "s3" && config.S3PrivateBucket != "" {
+		return NewS3Storage(
+			config.S3Endpoint,
+			config.S3Region,
+			config.S3PrivateBucket,
+			config.S3AccessKey,
+			config.S3SecretKey,
+			"",
+		)
+	}
+
+	return NewLocalStorage(config.PrivateLocalDir, "")
+}
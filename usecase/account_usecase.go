@@ -16,14 +16,23 @@ type AccountUsecase interface {
 	GetAccount(claims *helper.JWTCustomClaims, user *model.User) helper.APIError
 	UpdateAccount(claims *helper.JWTCustomClaims, user *model.User, req *request.UpdateUserRequest) helper.APIError
 	ResetPassword(claims *helper.JWTCustomClaims, user *model.User, req *request.UpdateUserPasswordRequest) helper.APIError
+	GetProfile(claims *helper.JWTCustomClaims, profile *model.OrganizerProfile)
+	UpdateProfile(claims *helper.JWTCustomClaims, profile *model.OrganizerProfile, req *request.UpdateOrganizerProfileRequest)
 }
 
 type accountUsecase struct {
-	userRepository r.UserRepository
+	userRepository             r.UserRepository
+	organizerProfileRepository r.OrganizerProfileRepository
 }
 
-func NewAccountUsecase(userRepository r.UserRepository) AccountUsecase {
-	return &accountUsecase{userRepository}
+func NewAccountUsecase(
+	userRepository r.UserRepository,
+	organizerProfileRepository r.OrganizerProfileRepository,
+) AccountUsecase {
+	return &accountUsecase{
+		userRepository,
+		organizerProfileRepository,
+	}
 }
 
 func (u *accountUsecase) GetAccount(claims *helper.JWTCustomClaims, user *model.User) helper.APIError {
@@ -70,3 +79,25 @@ func (u *accountUsecase) ResetPassword(claims *helper.JWTCustomClaims, user *mod
 
 	return nil
 }
+
+func (u *accountUsecase) GetProfile(claims *helper.JWTCustomClaims, profile *model.OrganizerProfile) {
+	u.organizerProfileRepository.FindByUserID(profile, claims.ID)
+	profile.UserID = claims.ID
+}
+
+func (u *accountUsecase) UpdateProfile(claims *helper.JWTCustomClaims, profile *model.OrganizerProfile, req *request.UpdateOrganizerProfileRequest) {
+	u.organizerProfileRepository.FindByUserID(profile, claims.ID)
+
+	profile.UserID = claims.ID
+	profile.DisplayName = req.DisplayName
+	profile.Bio = req.Bio
+	profile.City = req.City
+	profile.LogoURL = req.LogoURL
+	profile.Phone = req.Phone
+	profile.Email = req.Email
+	profile.Website = req.Website
+	profile.SocialLinks = req.SocialLinks
+	profile.FoundedYear = req.FoundedYear
+
+	u.organizerProfileRepository.Save(profile)
+}
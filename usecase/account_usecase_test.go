@@ -19,8 +19,9 @@ import (
 type accountUsecaseSuite struct {
 	suite.Suite
 
-	ctrl           *gomock.Controller
-	userRepository *mr.MockUserRepository
+	ctrl                       *gomock.Controller
+	userRepository             *mr.MockUserRepository
+	organizerProfileRepository *mr.MockOrganizerProfileRepository
 
 	usecase AccountUsecase
 }
@@ -30,8 +31,9 @@ func (s *accountUsecaseSuite) SetupSuite() {
 
 	s.ctrl = gomock.NewController(s.T())
 	s.userRepository = mr.NewMockUserRepository(s.ctrl)
+	s.organizerProfileRepository = mr.NewMockOrganizerProfileRepository(s.ctrl)
 
-	s.usecase = NewAccountUsecase(s.userRepository)
+	s.usecase = NewAccountUsecase(s.userRepository, s.organizerProfileRepository)
 }
 
 func (s *accountUsecaseSuite) TearDownSuite() {
@@ -209,3 +211,35 @@ func (s *accountUsecaseSuite) TestResetPassword() {
 		})
 	}
 }
+
+func (s *accountUsecaseSuite) TestGetProfile() {
+	s.organizerProfileRepository.EXPECT().FindByUserID(
+		gomock.Eq(&model.OrganizerProfile{}),
+		gomock.Eq(uint(1)),
+	)
+
+	profile := model.OrganizerProfile{}
+	s.usecase.GetProfile(&helper.JWTCustomClaims{ID: 1, Role: "organizer"}, &profile)
+	s.Equal(uint(1), profile.UserID)
+}
+
+func (s *accountUsecaseSuite) TestUpdateProfile() {
+	s.organizerProfileRepository.EXPECT().FindByUserID(
+		gomock.Eq(&model.OrganizerProfile{}),
+		gomock.Eq(uint(1)),
+	).SetArg(0, model.OrganizerProfile{Model: gorm.Model{ID: 3}, UserID: 1, DisplayName: "Old"})
+
+	s.organizerProfileRepository.EXPECT().Save(gomock.Any()).Do(func(profile *model.OrganizerProfile) {
+		s.Equal(uint(3), profile.ID)
+		s.Equal("Bali Weddings", profile.DisplayName)
+		s.Equal("Denpasar", profile.City)
+		s.Equal(2015, profile.FoundedYear)
+	})
+
+	req := &request.UpdateOrganizerProfileRequest{
+		DisplayName: "Bali Weddings",
+		City:        "Denpasar",
+		FoundedYear: 2015,
+	}
+	s.usecase.UpdateProfile(&helper.JWTCustomClaims{ID: 1, Role: "organizer"}, &model.OrganizerProfile{}, req)
+}
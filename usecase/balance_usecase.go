@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+)
+
+type BalanceUsecase interface {
+	GetBalance(claims *helper.JWTCustomClaims, balance *model.Balance)
+	GetTransactions(claims *helper.JWTCustomClaims, entries *[]model.LedgerEntry)
+	GetEarnings(claims *helper.JWTCustomClaims, payments *[]model.Payment)
+}
+
+type balanceUsecase struct {
+	ledgerRepository  r.LedgerRepository
+	paymentRepository r.PaymentRepository
+}
+
+func NewBalanceUsecase(ledgerRepository r.LedgerRepository, paymentRepository r.PaymentRepository) BalanceUsecase {
+	return &balanceUsecase{ledgerRepository, paymentRepository}
+}
+
+func (u *balanceUsecase) GetBalance(claims *helper.JWTCustomClaims, balance *model.Balance) {
+	balance.Available = u.ledgerRepository.GetBalance(model.LedgerAccountOrganizer, claims.ID)
+	balance.Escrow = u.ledgerRepository.GetBalance(model.LedgerAccountEscrow, claims.ID)
+	balance.Payout = u.ledgerRepository.GetBalance(model.LedgerAccountPayout, claims.ID)
+}
+
+func (u *balanceUsecase) GetTransactions(claims *helper.JWTCustomClaims, entries *[]model.LedgerEntry) {
+	u.ledgerRepository.GetEntries(entries, model.LedgerAccountOrganizer, claims.ID)
+}
+
+func (u *balanceUsecase) GetEarnings(claims *helper.JWTCustomClaims, payments *[]model.Payment) {
+	u.paymentRepository.GetEarnings(payments, claims.ID)
+}
+
+// newLedgerTransfer moves amount from one account to another within the
+// sub-ledger of the given organizer.
+func newLedgerTransfer(kind string, userID uint, amount model.Money, from, to string) *model.LedgerTransaction {
+	return &model.LedgerTransaction{
+		Kind: kind,
+		Entries: []model.LedgerEntry{
+			{Account: from, UserID: userID, Amount: -amount},
+			{Account: to, UserID: userID, Amount: amount},
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type balanceUsecaseSuite struct {
+	suite.Suite
+
+	ctrl              *gomock.Controller
+	ledgerRepository  *mr.MockLedgerRepository
+	paymentRepository *mr.MockPaymentRepository
+
+	usecase BalanceUsecase
+}
+
+func (s *balanceUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.ledgerRepository = mr.NewMockLedgerRepository(s.ctrl)
+	s.paymentRepository = mr.NewMockPaymentRepository(s.ctrl)
+
+	s.usecase = NewBalanceUsecase(s.ledgerRepository, s.paymentRepository)
+}
+
+func (s *balanceUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestBalanceUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(balanceUsecaseSuite))
+}
+
+func (s *balanceUsecaseSuite) TestGetBalance() {
+	testCases := []struct {
+		Name         string
+		Body         any
+		Claims       *helper.JWTCustomClaims
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"ok",
+			nil,
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.ledgerRepository.EXPECT().GetBalance(
+					gomock.Eq(model.LedgerAccountOrganizer),
+					gomock.Eq(uint(1)),
+				).Return(model.Money(1000))
+
+				s.ledgerRepository.EXPECT().GetBalance(
+					gomock.Eq(model.LedgerAccountEscrow),
+					gomock.Eq(uint(1)),
+				).Return(model.Money(500))
+
+				s.ledgerRepository.EXPECT().GetBalance(
+					gomock.Eq(model.LedgerAccountPayout),
+					gomock.Eq(uint(1)),
+				).Return(model.Money(0))
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			balance := model.Balance{}
+			s.usecase.GetBalance(testCase.Claims, &balance)
+			s.Equal(model.Money(1000), balance.Available)
+			s.Equal(model.Money(500), balance.Escrow)
+		})
+	}
+}
+
+func (s *balanceUsecaseSuite) TestGetTransactions() {
+	testCases := []struct {
+		Name         string
+		Body         any
+		Claims       *helper.JWTCustomClaims
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"ok",
+			nil,
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.ledgerRepository.EXPECT().GetEntries(
+					gomock.Eq(&[]model.LedgerEntry{}),
+					gomock.Eq(model.LedgerAccountOrganizer),
+					gomock.Eq(uint(1)),
+				)
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			s.usecase.GetTransactions(testCase.Claims, &[]model.LedgerEntry{})
+		})
+	}
+}
+
+func (s *balanceUsecaseSuite) TestGetEarnings() {
+	testCases := []struct {
+		Name         string
+		Body         any
+		Claims       *helper.JWTCustomClaims
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"ok",
+			nil,
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.paymentRepository.EXPECT().GetEarnings(
+					gomock.Eq(&[]model.Payment{}),
+					gomock.Eq(uint(1)),
+				)
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			s.usecase.GetEarnings(testCase.Claims, &[]model.Payment{})
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/labstack/echo/v4"
+)
+
+type CategoryUsecase interface {
+	GetCategories(categories *[]model.Category)
+	CreateCategory(claims *helper.JWTCustomClaims, category *model.Category, req *request.CreateCategoryRequest) helper.APIError
+	UpdateCategory(claims *helper.JWTCustomClaims, ctx echo.Context, category *model.Category, req *request.UpdateCategoryRequest) helper.APIError
+	DeleteCategory(claims *helper.JWTCustomClaims, ctx echo.Context, category *model.Category) helper.APIError
+}
+
+type categoryUsecase struct {
+	transactor               r.Transactor
+	categoryRepository       r.CategoryRepository
+	serviceRepository        r.ServiceRepository
+	commissionRateRepository r.CommissionRateRepository
+}
+
+func NewCategoryUsecase(
+	transactor r.Transactor,
+	categoryRepository r.CategoryRepository,
+	serviceRepository r.ServiceRepository,
+	commissionRateRepository r.CommissionRateRepository,
+) CategoryUsecase {
+	return &categoryUsecase{
+		transactor,
+		categoryRepository,
+		serviceRepository,
+		commissionRateRepository,
+	}
+}
+
+func (u *categoryUsecase) GetCategories(categories *[]model.Category) {
+	u.categoryRepository.Get(categories)
+}
+
+func (u *categoryUsecase) CreateCategory(claims *helper.JWTCustomClaims, category *model.Category, req *request.CreateCategoryRequest) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if apiError := u.validateCategory(category, &req.BasicCategory); apiError != nil {
+		return apiError
+	}
+
+	category.Name = req.Name
+	category.Slug = req.Slug
+	category.ParentID = req.ParentID
+	if err := u.categoryRepository.Save(category); err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+
+	return nil
+}
+
+func (u *categoryUsecase) UpdateCategory(claims *helper.JWTCustomClaims, ctx echo.Context, category *model.Category, req *request.UpdateCategoryRequest) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.categoryRepository.Find(category, ctx.Param("id"))
+
+	if category.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "category not found")
+	}
+
+	if apiError := u.validateCategory(category, &req.BasicCategory); apiError != nil {
+		return apiError
+	}
+
+	category.Name = req.Name
+	category.Slug = req.Slug
+	category.ParentID = req.ParentID
+	if err := u.categoryRepository.Save(category); err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+
+	return nil
+}
+
+func (u *categoryUsecase) DeleteCategory(claims *helper.JWTCustomClaims, ctx echo.Context, category *model.Category) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.categoryRepository.Find(category, ctx.Param("id"))
+
+	if category.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "category not found")
+	}
+
+	categories := make([]model.Category, 0)
+	u.categoryRepository.Get(&categories)
+
+	if len(descendantCategoryIDs(categories, category.ID)) > 1 {
+		return helper.NewAPIError(http.StatusBadRequest, "category has subcategories")
+	}
+
+	if u.serviceRepository.CountForCategory(category.ID) > 0 {
+		return helper.NewAPIError(http.StatusBadRequest, "category has services")
+	}
+
+	// The category is deleted for good along with its commission rate, so
+	// that neither its slug nor its rate outlives it.
+	err := u.transactor.Transaction(func(tx r.Tx) error {
+		if err := u.serviceRepository.WithTx(tx).UnsetDeletedCategory(category.ID); err != nil {
+			return err
+		}
+
+		if err := u.commissionRateRepository.WithTx(tx).DeleteByScope(model.CommissionScopeCategory, category.ID); err != nil {
+			return err
+		}
+
+		return u.categoryRepository.WithTx(tx).Delete(category)
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+
+	return nil
+}
+
+func (u *categoryUsecase) validateCategory(category *model.Category, req *request.BasicCategory) helper.APIError {
+	existing := model.Category{}
+	u.categoryRepository.FindBySlug(&existing, req.Slug)
+
+	if existing.ID > 0 && existing.ID != category.ID {
+		return helper.NewAPIError(http.StatusBadRequest, "slug already used")
+	}
+
+	if req.ParentID == nil {
+		return nil
+	}
+
+	parent := model.Category{}
+	u.categoryRepository.Find(&parent, *req.ParentID)
+
+	if parent.ID == 0 {
+		return helper.NewAPIError(http.StatusBadRequest, "parent category not found")
+	}
+
+	// A category cannot be moved under itself or one of its own
+	// subcategories, otherwise the tree would contain a cycle.
+	if category.ID > 0 {
+		categories := make([]model.Category, 0)
+		u.categoryRepository.Get(&categories)
+
+		for _, id := range descendantCategoryIDs(categories, category.ID) {
+			if id == parent.ID {
+				return helper.NewAPIError(http.StatusBadRequest, "invalid parent category")
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,362 @@
+package usecase
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type categoryUsecaseSuite struct {
+	suite.Suite
+
+	ctrl                     *gomock.Controller
+	transactor               *mr.MockTransactor
+	categoryRepository       *mr.MockCategoryRepository
+	serviceRepository        *mr.MockServiceRepository
+	commissionRateRepository *mr.MockCommissionRateRepository
+
+	usecase CategoryUsecase
+}
+
+func (s *categoryUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.transactor = mr.NewMockTransactor(s.ctrl)
+	s.categoryRepository = mr.NewMockCategoryRepository(s.ctrl)
+	s.serviceRepository = mr.NewMockServiceRepository(s.ctrl)
+	s.commissionRateRepository = mr.NewMockCommissionRateRepository(s.ctrl)
+
+	s.usecase = NewCategoryUsecase(s.transactor, s.categoryRepository, s.serviceRepository, s.commissionRateRepository)
+}
+
+func (s *categoryUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestCategoryUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(categoryUsecaseSuite))
+}
+
+func (s *categoryUsecaseSuite) TestGetCategories() {
+	s.categoryRepository.EXPECT().Get(gomock.Eq(&[]model.Category{}))
+
+	s.usecase.GetCategories(&[]model.Category{})
+}
+
+func (s *categoryUsecaseSuite) TestCreateCategory() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	parentID := uint(1)
+
+	testCases := []struct {
+		Name         string
+		Body         *request.CreateCategoryRequest
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"bad request",
+			&request.CreateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "venue"},
+			},
+			func() {
+				s.categoryRepository.EXPECT().FindBySlug(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("venue"),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}, Slug: "venue"})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"bad request",
+			&request.CreateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Ballroom", Slug: "ballroom", ParentID: &parentID},
+			},
+			func() {
+				s.categoryRepository.EXPECT().FindBySlug(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("ballroom"),
+				)
+
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq(parentID),
+				)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			&request.CreateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Ballroom", Slug: "ballroom", ParentID: &parentID},
+			},
+			func() {
+				s.categoryRepository.EXPECT().FindBySlug(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("ballroom"),
+				)
+
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq(parentID),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}, Slug: "venue"})
+
+				s.categoryRepository.EXPECT().Save(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"internal server error",
+			&request.CreateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Ballroom", Slug: "ballroom"},
+			},
+			func() {
+				s.categoryRepository.EXPECT().FindBySlug(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("ballroom"),
+				)
+
+				s.categoryRepository.EXPECT().Save(gomock.Any()).Return(errors.New("duplicate entry"))
+			},
+			http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			if apiError := s.usecase.CreateCategory(admin, &model.Category{}, testCase.Body); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *categoryUsecaseSuite) TestUpdateCategory() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	createContext := func(id string) echo.Context {
+		req := httptest.NewRequest("", "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues(id)
+		return ctx
+	}
+
+	parentID := uint(2)
+
+	testCases := []struct {
+		Name         string
+		Body         *request.UpdateCategoryRequest
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			&request.UpdateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "venue"},
+			},
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"bad request",
+			&request.UpdateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venue", Slug: "venue", ParentID: &parentID},
+			},
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}, Slug: "venue"})
+
+				s.categoryRepository.EXPECT().FindBySlug(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("venue"),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}, Slug: "venue"})
+
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq(parentID),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 2}, Slug: "ballroom"})
+
+				parent := uint(1)
+				s.categoryRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Category{}),
+				).SetArg(0, []model.Category{
+					{Model: gorm.Model{ID: 1}, Slug: "venue"},
+					{Model: gorm.Model{ID: 2}, Slug: "ballroom", ParentID: &parent},
+				})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			&request.UpdateCategoryRequest{
+				BasicCategory: request.BasicCategory{Name: "Venues", Slug: "venues"},
+			},
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}, Slug: "venue"})
+
+				s.categoryRepository.EXPECT().FindBySlug(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("venues"),
+				)
+
+				s.categoryRepository.EXPECT().Save(gomock.Any())
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			if apiError := s.usecase.UpdateCategory(admin, createContext("1"), &model.Category{}, testCase.Body); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *categoryUsecaseSuite) TestDeleteCategory() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	createContext := func(id string) echo.Context {
+		req := httptest.NewRequest("", "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues(id)
+		return ctx
+	}
+
+	parentID := uint(1)
+
+	testCases := []struct {
+		Name         string
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"bad request",
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}})
+
+				s.categoryRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Category{}),
+				).SetArg(0, []model.Category{
+					{Model: gorm.Model{ID: 1}},
+					{Model: gorm.Model{ID: 2}, ParentID: &parentID},
+				})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"has services",
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}})
+
+				s.categoryRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Category{}),
+				).SetArg(0, []model.Category{{Model: gorm.Model{ID: 1}}})
+
+				s.serviceRepository.EXPECT().CountForCategory(gomock.Eq(uint(1))).Return(int64(2))
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}})
+
+				s.categoryRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Category{}),
+				).SetArg(0, []model.Category{{Model: gorm.Model{ID: 1}}})
+
+				s.serviceRepository.EXPECT().CountForCategory(gomock.Eq(uint(1)))
+
+				s.transactor.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(tx r.Tx) error) error {
+					return fn(r.Tx{})
+				})
+				s.serviceRepository.EXPECT().WithTx(gomock.Any()).Return(s.serviceRepository)
+				s.serviceRepository.EXPECT().UnsetDeletedCategory(gomock.Eq(uint(1)))
+				s.commissionRateRepository.EXPECT().WithTx(gomock.Any()).Return(s.commissionRateRepository)
+				s.commissionRateRepository.EXPECT().DeleteByScope(gomock.Eq(model.CommissionScopeCategory), gomock.Eq(uint(1)))
+				s.categoryRepository.EXPECT().WithTx(gomock.Any()).Return(s.categoryRepository)
+				s.categoryRepository.EXPECT().Delete(gomock.Any())
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			if apiError := s.usecase.DeleteCategory(admin, createContext("1"), &model.Category{}); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *categoryUsecaseSuite) TestCategoryUnauthorized() {
+	claims := &helper.JWTCustomClaims{ID: 2, Role: "organizer"}
+
+	for _, apiError := range []helper.APIError{
+		s.usecase.CreateCategory(claims, &model.Category{}, &request.CreateCategoryRequest{}),
+		s.usecase.UpdateCategory(claims, nil, &model.Category{}, &request.UpdateCategoryRequest{}),
+		s.usecase.DeleteCategory(claims, nil, &model.Category{}),
+	} {
+		s.NotNil(apiError)
+		code, _ := apiError.APIError()
+		s.Equal(http.StatusUnauthorized, code)
+	}
+}
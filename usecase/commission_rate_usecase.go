@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"net/http"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+	"github.com/andikabahari/eoplatform/request"
+)
+
+type CommissionRateUsecase interface {
+	GetCommissionRates(claims *helper.JWTCustomClaims, rates *[]model.CommissionRate) helper.APIError
+	SetCommissionRate(claims *helper.JWTCustomClaims, rate *model.CommissionRate, req *request.SetCommissionRateRequest) helper.APIError
+	DeleteCommissionRate(claims *helper.JWTCustomClaims, rate *model.CommissionRate, id string) helper.APIError
+}
+
+type commissionRateUsecase struct {
+	commissionRateRepository r.CommissionRateRepository
+	userRepository           r.UserRepository
+	categoryRepository       r.CategoryRepository
+}
+
+func NewCommissionRateUsecase(
+	commissionRateRepository r.CommissionRateRepository,
+	userRepository r.UserRepository,
+	categoryRepository r.CategoryRepository,
+) CommissionRateUsecase {
+	return &commissionRateUsecase{
+		commissionRateRepository,
+		userRepository,
+		categoryRepository,
+	}
+}
+
+func (u *commissionRateUsecase) GetCommissionRates(claims *helper.JWTCustomClaims, rates *[]model.CommissionRate) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.commissionRateRepository.Get(rates)
+
+	return nil
+}
+
+func (u *commissionRateUsecase) SetCommissionRate(claims *helper.JWTCustomClaims, rate *model.CommissionRate, req *request.SetCommissionRateRequest) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if req.Scope == model.CommissionScopeOrganizer {
+		user := model.User{}
+		u.userRepository.Find(&user, req.ScopeID)
+
+		if user.ID == 0 || user.Role != "organizer" {
+			return helper.NewAPIError(http.StatusNotFound, "organizer not found")
+		}
+	}
+	if req.Scope == model.CommissionScopeCategory {
+		category := model.Category{}
+		u.categoryRepository.Find(&category, req.ScopeID)
+
+		if category.ID == 0 {
+			return helper.NewAPIError(http.StatusNotFound, "category not found")
+		}
+	}
+
+	u.commissionRateRepository.FindByScope(rate, req.Scope, req.ScopeID)
+
+	rate.Scope = req.Scope
+	rate.ScopeID = req.ScopeID
+	rate.Rate = req.Rate
+	u.commissionRateRepository.Save(rate)
+
+	return nil
+}
+
+func (u *commissionRateUsecase) DeleteCommissionRate(claims *helper.JWTCustomClaims, rate *model.CommissionRate, id string) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.commissionRateRepository.Find(rate, id)
+
+	if rate.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "commission rate not found")
+	}
+
+	u.commissionRateRepository.Delete(rate)
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type commissionRateUsecaseSuite struct {
+	suite.Suite
+
+	ctrl                     *gomock.Controller
+	commissionRateRepository *mr.MockCommissionRateRepository
+	userRepository           *mr.MockUserRepository
+	categoryRepository       *mr.MockCategoryRepository
+
+	usecase CommissionRateUsecase
+}
+
+func (s *commissionRateUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.commissionRateRepository = mr.NewMockCommissionRateRepository(s.ctrl)
+	s.userRepository = mr.NewMockUserRepository(s.ctrl)
+	s.categoryRepository = mr.NewMockCategoryRepository(s.ctrl)
+
+	s.usecase = NewCommissionRateUsecase(s.commissionRateRepository, s.userRepository, s.categoryRepository)
+}
+
+func (s *commissionRateUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestCommissionRateUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(commissionRateUsecaseSuite))
+}
+
+func (s *commissionRateUsecaseSuite) TestGetCommissionRates() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	s.commissionRateRepository.EXPECT().Get(gomock.Eq(&[]model.CommissionRate{}))
+	s.Nil(s.usecase.GetCommissionRates(admin, &[]model.CommissionRate{}))
+}
+
+func (s *commissionRateUsecaseSuite) TestSetCommissionRate() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	testCases := []struct {
+		Name         string
+		Body         *request.SetCommissionRateRequest
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			&request.SetCommissionRateRequest{
+				Scope:   model.CommissionScopeOrganizer,
+				ScopeID: 1,
+				Rate:    0.05,
+			},
+			func() {
+				s.userRepository.EXPECT().Find(
+					gomock.Eq(&model.User{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.User{Model: gorm.Model{ID: 1}, Role: "customer"})
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			&request.SetCommissionRateRequest{
+				Scope:   model.CommissionScopeOrganizer,
+				ScopeID: 1,
+				Rate:    0.05,
+			},
+			func() {
+				s.userRepository.EXPECT().Find(
+					gomock.Eq(&model.User{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.User{Model: gorm.Model{ID: 1}, Role: "organizer"})
+
+				s.commissionRateRepository.EXPECT().FindByScope(
+					gomock.Eq(&model.CommissionRate{}),
+					gomock.Eq(model.CommissionScopeOrganizer),
+					gomock.Eq(uint(1)),
+				)
+
+				s.commissionRateRepository.EXPECT().Save(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"not found",
+			&request.SetCommissionRateRequest{
+				Scope:   model.CommissionScopeCategory,
+				ScopeID: 1,
+				Rate:    0.05,
+			},
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq(uint(1)),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			&request.SetCommissionRateRequest{
+				Scope:   model.CommissionScopeCategory,
+				ScopeID: 1,
+				Rate:    0.05,
+			},
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}})
+
+				s.commissionRateRepository.EXPECT().FindByScope(
+					gomock.Eq(&model.CommissionRate{}),
+					gomock.Eq(model.CommissionScopeCategory),
+					gomock.Eq(uint(1)),
+				)
+
+				s.commissionRateRepository.EXPECT().Save(gomock.Any())
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			if apiError := s.usecase.SetCommissionRate(admin, &model.CommissionRate{}, testCase.Body); apiError != nil {
+				code, _ := apiError.APIError()
+				s.Equal(testCase.ExpectedCode, code)
+			}
+		})
+	}
+}
+
+func (s *commissionRateUsecaseSuite) TestDeleteCommissionRate() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	testCases := []struct {
+		Name         string
+		Body         any
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			nil,
+			func() {
+				s.commissionRateRepository.EXPECT().Find(
+					gomock.Eq(&model.CommissionRate{}),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			nil,
+			func() {
+				s.commissionRateRepository.EXPECT().Find(
+					gomock.Eq(&model.CommissionRate{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.CommissionRate{Model: gorm.Model{ID: 1}})
+
+				s.commissionRateRepository.EXPECT().Delete(gomock.Any())
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			if apiError := s.usecase.DeleteCommissionRate(admin, &model.CommissionRate{}, "1"); apiError != nil {
+				code, _ := apiError.APIError()
+				s.Equal(testCase.ExpectedCode, code)
+			}
+		})
+	}
+}
+
+func (s *commissionRateUsecaseSuite) TestCommissionRateUnauthorized() {
+	claims := &helper.JWTCustomClaims{ID: 2, Role: "organizer"}
+
+	for _, apiError := range []helper.APIError{
+		s.usecase.GetCommissionRates(claims, &[]model.CommissionRate{}),
+		s.usecase.SetCommissionRate(claims, &model.CommissionRate{}, &request.SetCommissionRateRequest{}),
+		s.usecase.DeleteCommissionRate(claims, &model.CommissionRate{}, "1"),
+	} {
+		s.NotNil(apiError)
+		code, _ := apiError.APIError()
+		s.Equal(http.StatusUnauthorized, code)
+	}
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+)
+
+type FavoriteUsecase interface {
+	GetFavorites(claims *helper.JWTCustomClaims, services *[]model.Service)
+	AddFavorite(claims *helper.JWTCustomClaims, service *model.Service, serviceID string) helper.APIError
+	RemoveFavorite(claims *helper.JWTCustomClaims, serviceID string) helper.APIError
+}
+
+type favoriteUsecase struct {
+	favoriteRepository r.FavoriteRepository
+	serviceRepository  r.ServiceRepository
+}
+
+func NewFavoriteUsecase(favoriteRepository r.FavoriteRepository, serviceRepository r.ServiceRepository) FavoriteUsecase {
+	return &favoriteUsecase{favoriteRepository, serviceRepository}
+}
+
+// GetFavorites lists the services the customer saved, most recent first.
+// Favorites of services that were archived or deleted since are left out.
+func (u *favoriteUsecase) GetFavorites(claims *helper.JWTCustomClaims, services *[]model.Service) {
+	serviceIDs := u.favoriteRepository.GetServiceIDs(claims.ID)
+	if len(serviceIDs) == 0 {
+		return
+	}
+
+	u.serviceRepository.Get(services, &model.ServiceFilter{
+		IDs:    serviceIDs,
+		Status: model.ServiceStatusPublished,
+		Sort:   model.ServiceSortRelevance,
+	})
+}
+
+func (u *favoriteUsecase) AddFavorite(claims *helper.JWTCustomClaims, service *model.Service, serviceID string) helper.APIError {
+	u.serviceRepository.Find(service, serviceID)
+
+	if service.ID == 0 || service.Status != model.ServiceStatusPublished {
+		return helper.NewAPIError(http.StatusNotFound, "service not found")
+	}
+
+	u.favoriteRepository.Create(&model.Favorite{
+		UserID:    claims.ID,
+		ServiceID: service.ID,
+	})
+
+	return nil
+}
+
+func (u *favoriteUsecase) RemoveFavorite(claims *helper.JWTCustomClaims, serviceID string) helper.APIError {
+	id, err := strconv.ParseUint(serviceID, 10, 64)
+	if err != nil {
+		return helper.NewAPIError(http.StatusNotFound, "service not found")
+	}
+
+	u.favoriteRepository.Delete(claims.ID, uint(id))
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type favoriteUsecaseSuite struct {
+	suite.Suite
+
+	ctrl               *gomock.Controller
+	favoriteRepository *mr.MockFavoriteRepository
+	serviceRepository  *mr.MockServiceRepository
+
+	usecase FavoriteUsecase
+}
+
+func (s *favoriteUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.favoriteRepository = mr.NewMockFavoriteRepository(s.ctrl)
+	s.serviceRepository = mr.NewMockServiceRepository(s.ctrl)
+
+	s.usecase = NewFavoriteUsecase(s.favoriteRepository, s.serviceRepository)
+}
+
+func (s *favoriteUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestFavoriteUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(favoriteUsecaseSuite))
+}
+
+func (s *favoriteUsecaseSuite) TestGetFavorites() {
+	testCases := []struct {
+		Name         string
+		ExpectedFunc func()
+	}{
+		{
+			"empty",
+			func() {
+				s.favoriteRepository.EXPECT().GetServiceIDs(gomock.Eq(uint(1))).Return([]uint{})
+			},
+		},
+		{
+			"ok",
+			func() {
+				s.favoriteRepository.EXPECT().GetServiceIDs(gomock.Eq(uint(1))).Return([]uint{3, 2})
+
+				s.serviceRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Service{}),
+					gomock.Eq(&model.ServiceFilter{
+						IDs:    []uint{3, 2},
+						Status: model.ServiceStatusPublished,
+						Sort:   model.ServiceSortRelevance,
+					}),
+				)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			claims := &helper.JWTCustomClaims{ID: 1, Role: "customer"}
+			s.usecase.GetFavorites(claims, &[]model.Service{})
+		})
+	}
+}
+
+func (s *favoriteUsecaseSuite) TestAddFavorite() {
+	testCases := []struct {
+		Name         string
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"archived",
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusArchived})
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusPublished})
+
+				s.favoriteRepository.EXPECT().Create(gomock.Eq(&model.Favorite{UserID: 2, ServiceID: 1}))
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			claims := &helper.JWTCustomClaims{ID: 2, Role: "customer"}
+			code := http.StatusOK
+			if apiError := s.usecase.AddFavorite(claims, &model.Service{}, "1"); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *favoriteUsecaseSuite) TestRemoveFavorite() {
+	testCases := []struct {
+		Name         string
+		ServiceID    string
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"invalid id",
+			"abc",
+			func() {},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			"1",
+			func() {
+				s.favoriteRepository.EXPECT().Delete(gomock.Eq(uint(2)), gomock.Eq(uint(1)))
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			claims := &helper.JWTCustomClaims{ID: 2, Role: "customer"}
+			code := http.StatusOK
+			if apiError := s.usecase.RemoveFavorite(claims, testCase.ServiceID); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
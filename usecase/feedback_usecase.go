@@ -1,28 +1,48 @@
 package usecase
 
 import (
+	"fmt"
 	"log"
 	"math"
 	"net/http"
+	"time"
 
 	"github.com/andikabahari/eoplatform/helper"
 	"github.com/andikabahari/eoplatform/model"
 	r "github.com/andikabahari/eoplatform/repository"
 	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/sentiment"
 )
 
 type FeedbackUsecase interface {
 	GetFeedbacks(feedbacks *[]model.Feedback, toUserID string)
 	CreateFeedback(claims *helper.JWTCustomClaims, feedback *model.Feedback, req *request.CreateFeedbackRequest) helper.APIError
+	ReplyFeedback(claims *helper.JWTCustomClaims, feedback *model.Feedback, id string, req *request.ReplyFeedbackRequest) helper.APIError
+	ScorePendingFeedbacks()
 }
 
 type feedbackUsecase struct {
-	feedbackRepository r.FeedbackRepository
-	userRepository     r.UserRepository
+	feedbackRepository        r.FeedbackRepository
+	userRepository            r.UserRepository
+	orderRepository           r.OrderRepository
+	ratingAggregateRepository r.RatingAggregateRepository
+	sentimentAnalyzer         sentiment.SentimentAnalyzer
 }
 
-func NewFeedbackUsecase(feedbackRepository r.FeedbackRepository, userRepository r.UserRepository) FeedbackUsecase {
-	return &feedbackUsecase{feedbackRepository, userRepository}
+func NewFeedbackUsecase(
+	feedbackRepository r.FeedbackRepository,
+	userRepository r.UserRepository,
+	orderRepository r.OrderRepository,
+	ratingAggregateRepository r.RatingAggregateRepository,
+	sentimentAnalyzer sentiment.SentimentAnalyzer,
+) FeedbackUsecase {
+	return &feedbackUsecase{
+		feedbackRepository,
+		userRepository,
+		orderRepository,
+		ratingAggregateRepository,
+		sentimentAnalyzer,
+	}
 }
 
 func (u *feedbackUsecase) GetFeedbacks(feedbacks *[]model.Feedback, toUserID string) {
@@ -30,34 +50,188 @@ func (u *feedbackUsecase) GetFeedbacks(feedbacks *[]model.Feedback, toUserID str
 }
 
 func (u *feedbackUsecase) CreateFeedback(claims *helper.JWTCustomClaims, feedback *model.Feedback, req *request.CreateFeedbackRequest) helper.APIError {
-	feedbacksCount := u.feedbackRepository.GetFeedbacksCount(claims.ID, req.ToUserID)
-	ordersCount := u.feedbackRepository.GetOrdersCount(claims.ID, req.ToUserID)
+	order := model.Order{}
+	u.orderRepository.Find(&order, fmt.Sprint(req.OrderID))
+
+	if order.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "order not found")
+	}
+
+	if order.UserID != claims.ID {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
 
-	if feedbacksCount >= ordersCount {
-		return helper.NewAPIError(http.StatusForbidden, "forbidden")
+	if !order.IsCompleted || len(order.Services) == 0 {
+		return helper.NewAPIError(http.StatusBadRequest, "order is not completed")
 	}
 
+	// All services of an order belong to the same organizer.
+	organizerID := order.Services[0].UserID
+
 	feedback.Description = req.Description
 	feedback.Rating = uint(req.Rating)
 	feedback.FromUserID = claims.ID
-	feedback.ToUserID = req.ToUserID
+	feedback.ToUserID = organizerID
+	feedback.OrderID = &order.ID
 
-	score, err := helper.AnalyzeSentiment(req.Description)
+	// Scoring calls out to the analyzer, so it is left to the background
+	// worker and the feedback is saved right away.
+	now := time.Now()
+	feedback.SentimentStatus = model.SentimentStatusPending
+	feedback.SentimentRetryAt = &now
+
+	// An order's feedback is unique, so of two concurrent reviews of the
+	// same order only the one saved first is counted.
+	created, err := u.feedbackRepository.Create(feedback)
 	if err != nil {
 		log.Printf("Error: %s", err)
 		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
 	}
+	if !created {
+		return helper.NewAPIError(http.StatusBadRequest, "order already reviewed")
+	}
+
+	u.addRating(*feedback, model.RatedTypeOrganizer, organizerID)
+	for _, service := range order.Services {
+		u.addRating(*feedback, model.RatedTypeService, service.ID)
+	}
+
+	feedback.Order = order
+	u.userRepository.Find(&feedback.FromUser, claims.ID)
+	u.userRepository.Find(&feedback.ToUser, organizerID)
+
+	return nil
+}
+
+// ReplyFeedback sets the organizer's reply to feedback they received. There
+// is one reply per feedback; replying again edits it.
+func (u *feedbackUsecase) ReplyFeedback(claims *helper.JWTCustomClaims, feedback *model.Feedback, id string, req *request.ReplyFeedbackRequest) helper.APIError {
+	u.feedbackRepository.Find(feedback, id)
+
+	if feedback.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "feedback not found")
+	}
+
+	if feedback.ToUserID != claims.ID {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	firstReply := feedback.RepliedAt == nil
+
+	now := time.Now()
+	feedback.Reply = req.Reply
+	feedback.RepliedAt = &now
+
+	u.feedbackRepository.UpdateReply(feedback)
+
+	if firstReply {
+		go notifyFeedbackReply(*feedback)
+	}
+
+	return nil
+}
+
+// notifyFeedbackReply emails the customer that the organizer replied to
+// their feedback, at the contact email of the reviewed order. It is meant
+// to run in its own goroutine, so failures are only logged.
+func notifyFeedbackReply(feedback model.Feedback) {
+	if feedback.Order.Email == "" {
+		return
+	}
+
+	message := fmt.Sprintf("Subject: %s replied to your feedback\r\n\r\n"+
+		"%s replied to your feedback:\r\n\r\n%s\r\n",
+		feedback.ToUser.Name, feedback.ToUser.Name, feedback.Reply)
+
+	if err := helper.SendEmail([]string{feedback.Order.Email}, message); err != nil {
+		log.Printf("Error: %s", err)
+	}
+}
+
+const (
+	sentimentBatchSize      = 20
+	maxSentimentAttempts    = 8
+	sentimentBackoffInitial = 30 * time.Second
+	sentimentBackoffMax     = time.Hour
+)
+
+// ScorePendingFeedbacks scores the feedbacks waiting for their sentiment
+// and counts the result in the ratings. Feedback the analyzer fails on is
+// retried with exponential backoff, up to maxSentimentAttempts times.
+func (u *feedbackUsecase) ScorePendingFeedbacks() {
+	feedbacks := make([]model.Feedback, 0)
+	u.feedbackRepository.GetPendingSentiment(&feedbacks, time.Now(), sentimentBatchSize)
+
+	for i := range feedbacks {
+		u.scoreFeedback(&feedbacks[i])
+	}
+}
+
+func (u *feedbackUsecase) scoreFeedback(feedback *model.Feedback) {
+	feedback.SentimentAttempts++
+
+	score, err := u.sentimentAnalyzer.Analyze(feedback.Description)
+	if err != nil {
+		log.Printf("Error: scoring feedback %d: %s", feedback.ID, err)
+
+		if feedback.SentimentAttempts >= maxSentimentAttempts {
+			feedback.SentimentStatus = model.SentimentStatusFailed
+			feedback.SentimentRetryAt = nil
+		} else {
+			retryAt := time.Now().Add(sentimentBackoff(feedback.SentimentAttempts))
+			feedback.SentimentRetryAt = &retryAt
+		}
+		u.feedbackRepository.UpdateSentiment(feedback)
+		return
+	}
 
 	if score >= 0 {
-		feedback.Positive = float64(score)
+		feedback.Positive = score
 	} else {
-		feedback.Negative = math.Abs(float64(score))
+		feedback.Negative = math.Abs(score)
 	}
+	feedback.SentimentStatus = model.SentimentStatusScored
+	feedback.SentimentRetryAt = nil
 
-	u.feedbackRepository.Create(feedback)
+	if !u.feedbackRepository.UpdateSentiment(feedback) || (feedback.Positive == 0 && feedback.Negative == 0) {
+		return
+	}
 
-	u.userRepository.Find(&feedback.FromUser, claims.ID)
-	u.userRepository.Find(&feedback.ToUser, req.ToUserID)
+	u.addSentiment(*feedback, model.RatedTypeOrganizer, feedback.ToUserID)
+	for _, service := range feedback.Order.Services {
+		u.addSentiment(*feedback, model.RatedTypeService, service.ID)
+	}
+}
 
-	return nil
+// sentimentBackoff is how long to wait before the next attempt after the
+// given number of failed ones.
+func sentimentBackoff(attempts int) time.Duration {
+	backoff := sentimentBackoffInitial
+	for i := 1; i < attempts && backoff < sentimentBackoffMax; i++ {
+		backoff *= 2
+	}
+	if backoff > sentimentBackoffMax {
+		backoff = sentimentBackoffMax
+	}
+
+	return backoff
+}
+
+// addSentiment counts the sentiment of feedback, whose rating was already
+// counted when it was created.
+func (u *feedbackUsecase) addSentiment(feedback model.Feedback, ratedType string, ratedID uint) {
+	aggregate := model.RatingAggregate{RatedType: ratedType, RatedID: ratedID}
+	if feedback.Positive > 0 {
+		aggregate.PositiveCount++
+	}
+	if feedback.Negative > 0 {
+		aggregate.NegativeCount++
+	}
+	u.ratingAggregateRepository.Increment(&aggregate)
+}
+
+func (u *feedbackUsecase) addRating(feedback model.Feedback, ratedType string, ratedID uint) {
+	aggregate := model.RatingAggregate{RatedType: ratedType, RatedID: ratedID}
+	aggregate.Add(feedback)
+	u.ratingAggregateRepository.Increment(&aggregate)
 }
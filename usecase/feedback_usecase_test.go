@@ -1,24 +1,31 @@
 package usecase
 
 import (
+	"errors"
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/andikabahari/eoplatform/helper"
 	"github.com/andikabahari/eoplatform/model"
 	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
 	"github.com/andikabahari/eoplatform/request"
+	msentiment "github.com/andikabahari/eoplatform/sentiment/mock_sentiment"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 )
 
 type feedbackUsecaseSuite struct {
 	suite.Suite
 
-	ctrl               *gomock.Controller
-	feedbackRepository *mr.MockFeedbackRepository
-	userRepository     *mr.MockUserRepository
+	ctrl                      *gomock.Controller
+	feedbackRepository        *mr.MockFeedbackRepository
+	userRepository            *mr.MockUserRepository
+	orderRepository           *mr.MockOrderRepository
+	ratingAggregateRepository *mr.MockRatingAggregateRepository
+	sentimentAnalyzer         *msentiment.MockSentimentAnalyzer
 
 	usecase FeedbackUsecase
 }
@@ -29,8 +36,11 @@ func (s *feedbackUsecaseSuite) SetupSuite() {
 	s.ctrl = gomock.NewController(s.T())
 	s.feedbackRepository = mr.NewMockFeedbackRepository(s.ctrl)
 	s.userRepository = mr.NewMockUserRepository(s.ctrl)
+	s.orderRepository = mr.NewMockOrderRepository(s.ctrl)
+	s.ratingAggregateRepository = mr.NewMockRatingAggregateRepository(s.ctrl)
+	s.sentimentAnalyzer = msentiment.NewMockSentimentAnalyzer(s.ctrl)
 
-	s.usecase = NewFeedbackUsecase(s.feedbackRepository, s.userRepository)
+	s.usecase = NewFeedbackUsecase(s.feedbackRepository, s.userRepository, s.orderRepository, s.ratingAggregateRepository, s.sentimentAnalyzer)
 }
 
 func (s *feedbackUsecaseSuite) TearDownSuite() {
@@ -80,25 +90,118 @@ func (s *feedbackUsecaseSuite) TestCreateFeedback() {
 		ExpectedCode int
 	}{
 		{
-			"forbidden",
+			"order not found",
 			&request.CreateFeedbackRequest{
 				Description: "Good job!",
 				Rating:      5,
-				ToUserID:    1,
+				OrderID:     3,
 			},
 			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
 			func() {
-				s.feedbackRepository.EXPECT().GetFeedbacksCount(
-					gomock.Eq(uint(1)),
-					gomock.Eq(uint(1)),
-				)
-
-				s.feedbackRepository.EXPECT().GetOrdersCount(
-					gomock.Eq(uint(1)),
-					gomock.Eq(uint(1)),
-				)
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("3"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"someone else's order",
+			&request.CreateFeedbackRequest{
+				Description: "Good job!",
+				Rating:      5,
+				OrderID:     3,
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("3")).SetArg(0, model.Order{
+					Model:       gorm.Model{ID: 3},
+					IsCompleted: true,
+					UserID:      4,
+					Services:    []model.Service{{Model: gorm.Model{ID: 5}, UserID: 2}},
+				})
+			},
+			http.StatusUnauthorized,
+		},
+		{
+			"order not completed",
+			&request.CreateFeedbackRequest{
+				Description: "Good job!",
+				Rating:      5,
+				OrderID:     3,
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("3")).SetArg(0, model.Order{
+					Model:    gorm.Model{ID: 3},
+					UserID:   1,
+					Services: []model.Service{{Model: gorm.Model{ID: 5}, UserID: 2}},
+				})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"order already reviewed",
+			&request.CreateFeedbackRequest{
+				Description: "Good job!",
+				Rating:      5,
+				OrderID:     3,
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("3")).SetArg(0, model.Order{
+					Model:       gorm.Model{ID: 3},
+					IsCompleted: true,
+					UserID:      1,
+					Services:    []model.Service{{Model: gorm.Model{ID: 5}, UserID: 2}},
+				})
+				s.feedbackRepository.EXPECT().Create(gomock.Any()).Return(false, nil)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"internal server error",
+			&request.CreateFeedbackRequest{
+				Description: "Good job!",
+				Rating:      5,
+				OrderID:     3,
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("3")).SetArg(0, model.Order{
+					Model:       gorm.Model{ID: 3},
+					IsCompleted: true,
+					UserID:      1,
+					Services:    []model.Service{{Model: gorm.Model{ID: 5}, UserID: 2}},
+				})
+				s.feedbackRepository.EXPECT().Create(gomock.Any()).Return(false, errors.New("connection refused"))
 			},
-			http.StatusForbidden,
+			http.StatusInternalServerError,
+		},
+		{
+			"ok",
+			&request.CreateFeedbackRequest{
+				Description: "Good job!",
+				Rating:      5,
+				OrderID:     3,
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("3")).SetArg(0, model.Order{
+					Model:       gorm.Model{ID: 3},
+					IsCompleted: true,
+					UserID:      1,
+					Services:    []model.Service{{Model: gorm.Model{ID: 5}, UserID: 2}},
+				})
+				s.feedbackRepository.EXPECT().Create(gomock.Any()).DoAndReturn(func(feedback *model.Feedback) (bool, error) {
+					s.Equal(uint(2), feedback.ToUserID)
+					s.Equal(uint(3), *feedback.OrderID)
+					s.Equal(model.SentimentStatusPending, feedback.SentimentStatus)
+					s.NotNil(feedback.SentimentRetryAt)
+					return true, nil
+				})
+				s.ratingAggregateRepository.EXPECT().Increment(gomock.Any()).Times(2)
+				s.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq(uint(1)))
+				s.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq(uint(2)))
+			},
+			http.StatusOK,
 		},
 	}
 
@@ -112,3 +215,168 @@ func (s *feedbackUsecaseSuite) TestCreateFeedback() {
 		})
 	}
 }
+
+func (s *feedbackUsecaseSuite) TestReplyFeedback() {
+	repliedAt := time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name         string
+		Claims       *helper.JWTCustomClaims
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			&helper.JWTCustomClaims{ID: 2, Role: "organizer"},
+			func() {
+				s.feedbackRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"someone else's feedback",
+			&helper.JWTCustomClaims{ID: 3, Role: "organizer"},
+			func() {
+				s.feedbackRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, model.Feedback{Model: gorm.Model{ID: 1}, ToUserID: 2})
+			},
+			http.StatusUnauthorized,
+		},
+		{
+			"replied",
+			&helper.JWTCustomClaims{ID: 2, Role: "organizer"},
+			func() {
+				s.feedbackRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, model.Feedback{Model: gorm.Model{ID: 1}, ToUserID: 2})
+				s.feedbackRepository.EXPECT().UpdateReply(gomock.Any()).Do(func(feedback *model.Feedback) {
+					s.Equal("Thank you!", feedback.Reply)
+					s.NotNil(feedback.RepliedAt)
+				})
+			},
+			http.StatusOK,
+		},
+		{
+			"reply edited",
+			&helper.JWTCustomClaims{ID: 2, Role: "organizer"},
+			func() {
+				s.feedbackRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, model.Feedback{
+					Model:     gorm.Model{ID: 1},
+					Reply:     "Thanks",
+					RepliedAt: &repliedAt,
+					ToUserID:  2,
+				})
+				s.feedbackRepository.EXPECT().UpdateReply(gomock.Any()).Do(func(feedback *model.Feedback) {
+					s.Equal("Thank you!", feedback.Reply)
+					s.True(feedback.RepliedAt.After(repliedAt))
+				})
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			req := &request.ReplyFeedbackRequest{Reply: "Thank you!"}
+			if apiError := s.usecase.ReplyFeedback(testCase.Claims, &model.Feedback{}, "1", req); apiError != nil {
+				code, _ := apiError.APIError()
+				s.Equal(testCase.ExpectedCode, code)
+			}
+		})
+	}
+}
+
+func (s *feedbackUsecaseSuite) TestScorePendingFeedbacks() {
+	pending := func(attempts int) []model.Feedback {
+		return []model.Feedback{{
+			Model:             gorm.Model{ID: 5},
+			Description:       "Good job!",
+			SentimentStatus:   model.SentimentStatusPending,
+			SentimentAttempts: attempts,
+			FromUserID:        1,
+			ToUserID:          2,
+			Order:             model.Order{Services: []model.Service{{Model: gorm.Model{ID: 3}}}},
+		}}
+	}
+
+	testCases := []struct {
+		Name         string
+		ExpectedFunc func()
+	}{
+		{
+			"none pending",
+			func() {
+				s.feedbackRepository.EXPECT().GetPendingSentiment(gomock.Any(), gomock.Any(), gomock.Eq(sentimentBatchSize))
+			},
+		},
+		{
+			"scored",
+			func() {
+				s.feedbackRepository.EXPECT().GetPendingSentiment(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(0, pending(0))
+				s.sentimentAnalyzer.EXPECT().Analyze(gomock.Eq("Good job!")).Return(0.8, nil)
+				s.feedbackRepository.EXPECT().UpdateSentiment(gomock.Any()).DoAndReturn(func(feedback *model.Feedback) bool {
+					s.Equal(model.SentimentStatusScored, feedback.SentimentStatus)
+					s.Equal(0.8, feedback.Positive)
+					s.Equal(1, feedback.SentimentAttempts)
+					s.Nil(feedback.SentimentRetryAt)
+					return true
+				})
+				s.ratingAggregateRepository.EXPECT().Increment(gomock.Eq(&model.RatingAggregate{
+					RatedType:     model.RatedTypeOrganizer,
+					RatedID:       2,
+					PositiveCount: 1,
+				}))
+				s.ratingAggregateRepository.EXPECT().Increment(gomock.Eq(&model.RatingAggregate{
+					RatedType:     model.RatedTypeService,
+					RatedID:       3,
+					PositiveCount: 1,
+				}))
+			},
+		},
+		{
+			"scored by another worker",
+			func() {
+				s.feedbackRepository.EXPECT().GetPendingSentiment(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(0, pending(0))
+				s.sentimentAnalyzer.EXPECT().Analyze(gomock.Any()).Return(-0.4, nil)
+				s.feedbackRepository.EXPECT().UpdateSentiment(gomock.Any()).Return(false)
+			},
+		},
+		{
+			"retried",
+			func() {
+				s.feedbackRepository.EXPECT().GetPendingSentiment(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(0, pending(1))
+				s.sentimentAnalyzer.EXPECT().Analyze(gomock.Any()).Return(0.0, errors.New("unavailable"))
+				s.feedbackRepository.EXPECT().UpdateSentiment(gomock.Any()).DoAndReturn(func(feedback *model.Feedback) bool {
+					s.Equal(model.SentimentStatusPending, feedback.SentimentStatus)
+					s.Equal(2, feedback.SentimentAttempts)
+					s.WithinDuration(time.Now().Add(time.Minute), *feedback.SentimentRetryAt, time.Second)
+					return true
+				})
+			},
+		},
+		{
+			"given up",
+			func() {
+				s.feedbackRepository.EXPECT().GetPendingSentiment(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(0, pending(maxSentimentAttempts-1))
+				s.sentimentAnalyzer.EXPECT().Analyze(gomock.Any()).Return(0.0, errors.New("unavailable"))
+				s.feedbackRepository.EXPECT().UpdateSentiment(gomock.Any()).DoAndReturn(func(feedback *model.Feedback) bool {
+					s.Equal(model.SentimentStatusFailed, feedback.SentimentStatus)
+					s.Nil(feedback.SentimentRetryAt)
+					return true
+				})
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			s.usecase.ScorePendingFeedbacks()
+		})
+	}
+}
+
+func (s *feedbackUsecaseSuite) TestSentimentBackoff() {
+	s.Equal(30*time.Second, sentimentBackoff(1))
+	s.Equal(time.Minute, sentimentBackoff(2))
+	s.Equal(8*time.Minute, sentimentBackoff(5))
+	s.Equal(time.Hour, sentimentBackoff(20))
+}
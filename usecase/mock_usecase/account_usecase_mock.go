@@ -50,6 +50,18 @@ func (mr *MockAccountUsecaseMockRecorder) GetAccount(claims, user interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountUsecase)(nil).GetAccount), claims, user)
 }
 
+// GetProfile mocks base method.
+func (m *MockAccountUsecase) GetProfile(claims *helper.JWTCustomClaims, profile *model.OrganizerProfile) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetProfile", claims, profile)
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockAccountUsecaseMockRecorder) GetProfile(claims, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockAccountUsecase)(nil).GetProfile), claims, profile)
+}
+
 // ResetPassword mocks base method.
 func (m *MockAccountUsecase) ResetPassword(claims *helper.JWTCustomClaims, user *model.User, req *request.UpdateUserPasswordRequest) helper.APIError {
 	m.ctrl.T.Helper()
@@ -77,3 +89,15 @@ func (mr *MockAccountUsecaseMockRecorder) UpdateAccount(claims, user, req interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockAccountUsecase)(nil).UpdateAccount), claims, user, req)
 }
+
+// UpdateProfile mocks base method.
+func (m *MockAccountUsecase) UpdateProfile(claims *helper.JWTCustomClaims, profile *model.OrganizerProfile, req *request.UpdateOrganizerProfileRequest) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateProfile", claims, profile, req)
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockAccountUsecaseMockRecorder) UpdateProfile(claims, profile, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockAccountUsecase)(nil).UpdateProfile), claims, profile, req)
+}
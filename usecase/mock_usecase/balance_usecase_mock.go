@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/balance_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBalanceUsecase is a mock of BalanceUsecase interface.
+type MockBalanceUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceUsecaseMockRecorder
+}
+
+// MockBalanceUsecaseMockRecorder is the mock recorder for MockBalanceUsecase.
+type MockBalanceUsecaseMockRecorder struct {
+	mock *MockBalanceUsecase
+}
+
+// NewMockBalanceUsecase creates a new mock instance.
+func NewMockBalanceUsecase(ctrl *gomock.Controller) *MockBalanceUsecase {
+	mock := &MockBalanceUsecase{ctrl: ctrl}
+	mock.recorder = &MockBalanceUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceUsecase) EXPECT() *MockBalanceUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockBalanceUsecase) GetBalance(claims *helper.JWTCustomClaims, balance *model.Balance) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetBalance", claims, balance)
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockBalanceUsecaseMockRecorder) GetBalance(claims, balance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBalanceUsecase)(nil).GetBalance), claims, balance)
+}
+
+// GetEarnings mocks base method.
+func (m *MockBalanceUsecase) GetEarnings(claims *helper.JWTCustomClaims, payments *[]model.Payment) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetEarnings", claims, payments)
+}
+
+// GetEarnings indicates an expected call of GetEarnings.
+func (mr *MockBalanceUsecaseMockRecorder) GetEarnings(claims, payments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEarnings", reflect.TypeOf((*MockBalanceUsecase)(nil).GetEarnings), claims, payments)
+}
+
+// GetTransactions mocks base method.
+func (m *MockBalanceUsecase) GetTransactions(claims *helper.JWTCustomClaims, entries *[]model.LedgerEntry) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetTransactions", claims, entries)
+}
+
+// GetTransactions indicates an expected call of GetTransactions.
+func (mr *MockBalanceUsecaseMockRecorder) GetTransactions(claims, entries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockBalanceUsecase)(nil).GetTransactions), claims, entries)
+}
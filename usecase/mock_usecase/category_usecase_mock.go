@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/category_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	request "github.com/andikabahari/eoplatform/request"
+	gomock "github.com/golang/mock/gomock"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockCategoryUsecase is a mock of CategoryUsecase interface.
+type MockCategoryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryUsecaseMockRecorder
+}
+
+// MockCategoryUsecaseMockRecorder is the mock recorder for MockCategoryUsecase.
+type MockCategoryUsecaseMockRecorder struct {
+	mock *MockCategoryUsecase
+}
+
+// NewMockCategoryUsecase creates a new mock instance.
+func NewMockCategoryUsecase(ctrl *gomock.Controller) *MockCategoryUsecase {
+	mock := &MockCategoryUsecase{ctrl: ctrl}
+	mock.recorder = &MockCategoryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryUsecase) EXPECT() *MockCategoryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockCategoryUsecase) CreateCategory(claims *helper.JWTCustomClaims, category *model.Category, req *request.CreateCategoryRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", claims, category, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoryUsecaseMockRecorder) CreateCategory(claims, category, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoryUsecase)(nil).CreateCategory), claims, category, req)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockCategoryUsecase) DeleteCategory(claims *helper.JWTCustomClaims, ctx echo.Context, category *model.Category) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", claims, ctx, category)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockCategoryUsecaseMockRecorder) DeleteCategory(claims, ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryUsecase)(nil).DeleteCategory), claims, ctx, category)
+}
+
+// GetCategories mocks base method.
+func (m *MockCategoryUsecase) GetCategories(categories *[]model.Category) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetCategories", categories)
+}
+
+// GetCategories indicates an expected call of GetCategories.
+func (mr *MockCategoryUsecaseMockRecorder) GetCategories(categories interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockCategoryUsecase)(nil).GetCategories), categories)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockCategoryUsecase) UpdateCategory(claims *helper.JWTCustomClaims, ctx echo.Context, category *model.Category, req *request.UpdateCategoryRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", claims, ctx, category, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockCategoryUsecaseMockRecorder) UpdateCategory(claims, ctx, category, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryUsecase)(nil).UpdateCategory), claims, ctx, category, req)
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/commission_rate_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	request "github.com/andikabahari/eoplatform/request"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCommissionRateUsecase is a mock of CommissionRateUsecase interface.
+type MockCommissionRateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommissionRateUsecaseMockRecorder
+}
+
+// MockCommissionRateUsecaseMockRecorder is the mock recorder for MockCommissionRateUsecase.
+type MockCommissionRateUsecaseMockRecorder struct {
+	mock *MockCommissionRateUsecase
+}
+
+// NewMockCommissionRateUsecase creates a new mock instance.
+func NewMockCommissionRateUsecase(ctrl *gomock.Controller) *MockCommissionRateUsecase {
+	mock := &MockCommissionRateUsecase{ctrl: ctrl}
+	mock.recorder = &MockCommissionRateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommissionRateUsecase) EXPECT() *MockCommissionRateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteCommissionRate mocks base method.
+func (m *MockCommissionRateUsecase) DeleteCommissionRate(claims *helper.JWTCustomClaims, rate *model.CommissionRate, id string) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCommissionRate", claims, rate, id)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// DeleteCommissionRate indicates an expected call of DeleteCommissionRate.
+func (mr *MockCommissionRateUsecaseMockRecorder) DeleteCommissionRate(claims, rate, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommissionRate", reflect.TypeOf((*MockCommissionRateUsecase)(nil).DeleteCommissionRate), claims, rate, id)
+}
+
+// GetCommissionRates mocks base method.
+func (m *MockCommissionRateUsecase) GetCommissionRates(claims *helper.JWTCustomClaims, rates *[]model.CommissionRate) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommissionRates", claims, rates)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// GetCommissionRates indicates an expected call of GetCommissionRates.
+func (mr *MockCommissionRateUsecaseMockRecorder) GetCommissionRates(claims, rates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommissionRates", reflect.TypeOf((*MockCommissionRateUsecase)(nil).GetCommissionRates), claims, rates)
+}
+
+// SetCommissionRate mocks base method.
+func (m *MockCommissionRateUsecase) SetCommissionRate(claims *helper.JWTCustomClaims, rate *model.CommissionRate, req *request.SetCommissionRateRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCommissionRate", claims, rate, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// SetCommissionRate indicates an expected call of SetCommissionRate.
+func (mr *MockCommissionRateUsecaseMockRecorder) SetCommissionRate(claims, rate, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommissionRate", reflect.TypeOf((*MockCommissionRateUsecase)(nil).SetCommissionRate), claims, rate, req)
+}
@@ -0,0 +1,76 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/favorite_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFavoriteUsecase is a mock of FavoriteUsecase interface.
+type MockFavoriteUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFavoriteUsecaseMockRecorder
+}
+
+// MockFavoriteUsecaseMockRecorder is the mock recorder for MockFavoriteUsecase.
+type MockFavoriteUsecaseMockRecorder struct {
+	mock *MockFavoriteUsecase
+}
+
+// NewMockFavoriteUsecase creates a new mock instance.
+func NewMockFavoriteUsecase(ctrl *gomock.Controller) *MockFavoriteUsecase {
+	mock := &MockFavoriteUsecase{ctrl: ctrl}
+	mock.recorder = &MockFavoriteUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFavoriteUsecase) EXPECT() *MockFavoriteUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddFavorite mocks base method.
+func (m *MockFavoriteUsecase) AddFavorite(claims *helper.JWTCustomClaims, service *model.Service, serviceID string) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavorite", claims, service, serviceID)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// AddFavorite indicates an expected call of AddFavorite.
+func (mr *MockFavoriteUsecaseMockRecorder) AddFavorite(claims, service, serviceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavorite", reflect.TypeOf((*MockFavoriteUsecase)(nil).AddFavorite), claims, service, serviceID)
+}
+
+// GetFavorites mocks base method.
+func (m *MockFavoriteUsecase) GetFavorites(claims *helper.JWTCustomClaims, services *[]model.Service) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetFavorites", claims, services)
+}
+
+// GetFavorites indicates an expected call of GetFavorites.
+func (mr *MockFavoriteUsecaseMockRecorder) GetFavorites(claims, services interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavorites", reflect.TypeOf((*MockFavoriteUsecase)(nil).GetFavorites), claims, services)
+}
+
+// RemoveFavorite mocks base method.
+func (m *MockFavoriteUsecase) RemoveFavorite(claims *helper.JWTCustomClaims, serviceID string) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavorite", claims, serviceID)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// RemoveFavorite indicates an expected call of RemoveFavorite.
+func (mr *MockFavoriteUsecaseMockRecorder) RemoveFavorite(claims, serviceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavorite", reflect.TypeOf((*MockFavoriteUsecase)(nil).RemoveFavorite), claims, serviceID)
+}
@@ -61,3 +61,29 @@ func (mr *MockFeedbackUsecaseMockRecorder) GetFeedbacks(feedbacks, toUserID inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedbacks", reflect.TypeOf((*MockFeedbackUsecase)(nil).GetFeedbacks), feedbacks, toUserID)
 }
+
+// ReplyFeedback mocks base method.
+func (m *MockFeedbackUsecase) ReplyFeedback(claims *helper.JWTCustomClaims, feedback *model.Feedback, id string, req *request.ReplyFeedbackRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyFeedback", claims, feedback, id, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// ReplyFeedback indicates an expected call of ReplyFeedback.
+func (mr *MockFeedbackUsecaseMockRecorder) ReplyFeedback(claims, feedback, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyFeedback", reflect.TypeOf((*MockFeedbackUsecase)(nil).ReplyFeedback), claims, feedback, id, req)
+}
+
+// ScorePendingFeedbacks mocks base method.
+func (m *MockFeedbackUsecase) ScorePendingFeedbacks() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ScorePendingFeedbacks")
+}
+
+// ScorePendingFeedbacks indicates an expected call of ScorePendingFeedbacks.
+func (mr *MockFeedbackUsecaseMockRecorder) ScorePendingFeedbacks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScorePendingFeedbacks", reflect.TypeOf((*MockFeedbackUsecase)(nil).ScorePendingFeedbacks))
+}
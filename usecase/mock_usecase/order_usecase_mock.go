@@ -5,6 +5,7 @@
 package mock_usecase
 
 import (
+	io "io"
 	reflect "reflect"
 
 	helper "github.com/andikabahari/eoplatform/helper"
@@ -38,17 +39,17 @@ func (m *MockOrderUsecase) EXPECT() *MockOrderUsecaseMockRecorder {
 }
 
 // AcceptOrCompleteOrder mocks base method.
-func (m *MockOrderUsecase) AcceptOrCompleteOrder(ctx echo.Context, order *model.Order) helper.APIError {
+func (m *MockOrderUsecase) AcceptOrCompleteOrder(ctx echo.Context, order *model.Order, payment *model.Payment) helper.APIError {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AcceptOrCompleteOrder", ctx, order)
+	ret := m.ctrl.Call(m, "AcceptOrCompleteOrder", ctx, order, payment)
 	ret0, _ := ret[0].(helper.APIError)
 	return ret0
 }
 
 // AcceptOrCompleteOrder indicates an expected call of AcceptOrCompleteOrder.
-func (mr *MockOrderUsecaseMockRecorder) AcceptOrCompleteOrder(ctx, order interface{}) *gomock.Call {
+func (mr *MockOrderUsecaseMockRecorder) AcceptOrCompleteOrder(ctx, order, payment interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrCompleteOrder", reflect.TypeOf((*MockOrderUsecase)(nil).AcceptOrCompleteOrder), ctx, order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrCompleteOrder", reflect.TypeOf((*MockOrderUsecase)(nil).AcceptOrCompleteOrder), ctx, order, payment)
 }
 
 // CancelOrder mocks base method.
@@ -65,6 +66,20 @@ func (mr *MockOrderUsecaseMockRecorder) CancelOrder(ctx, order interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrder", reflect.TypeOf((*MockOrderUsecase)(nil).CancelOrder), ctx, order)
 }
 
+// ConfirmOrRejectReceipt mocks base method.
+func (m *MockOrderUsecase) ConfirmOrRejectReceipt(ctx echo.Context, order *model.Order, payment *model.Payment, req *request.ReviewReceiptRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmOrRejectReceipt", ctx, order, payment, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// ConfirmOrRejectReceipt indicates an expected call of ConfirmOrRejectReceipt.
+func (mr *MockOrderUsecaseMockRecorder) ConfirmOrRejectReceipt(ctx, order, payment, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmOrRejectReceipt", reflect.TypeOf((*MockOrderUsecase)(nil).ConfirmOrRejectReceipt), ctx, order, payment, req)
+}
+
 // CreateOrder mocks base method.
 func (m *MockOrderUsecase) CreateOrder(claims *helper.JWTCustomClaims, order *model.Order, req *request.CreateOrderRequest) helper.APIError {
 	m.ctrl.T.Helper()
@@ -91,6 +106,35 @@ func (mr *MockOrderUsecaseMockRecorder) GetOrders(claims, orders, payments inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderUsecase)(nil).GetOrders), claims, orders, payments)
 }
 
+// GetReceipt mocks base method.
+func (m *MockOrderUsecase) GetReceipt(ctx echo.Context, payment *model.Payment) (io.ReadCloser, helper.APIError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceipt", ctx, payment)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(helper.APIError)
+	return ret0, ret1
+}
+
+// GetReceipt indicates an expected call of GetReceipt.
+func (mr *MockOrderUsecaseMockRecorder) GetReceipt(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceipt", reflect.TypeOf((*MockOrderUsecase)(nil).GetReceipt), ctx, payment)
+}
+
+// GetWebhookNotifications mocks base method.
+func (m *MockOrderUsecase) GetWebhookNotifications(claims *helper.JWTCustomClaims, notifications *[]model.WebhookNotification, status string) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookNotifications", claims, notifications, status)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// GetWebhookNotifications indicates an expected call of GetWebhookNotifications.
+func (mr *MockOrderUsecaseMockRecorder) GetWebhookNotifications(claims, notifications, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookNotifications", reflect.TypeOf((*MockOrderUsecase)(nil).GetWebhookNotifications), claims, notifications, status)
+}
+
 // PaymentStatus mocks base method.
 func (m *MockOrderUsecase) PaymentStatus(req *request.MidtransTransactionNotificationRequest) helper.APIError {
 	m.ctrl.T.Helper()
@@ -104,3 +148,31 @@ func (mr *MockOrderUsecaseMockRecorder) PaymentStatus(req interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentStatus", reflect.TypeOf((*MockOrderUsecase)(nil).PaymentStatus), req)
 }
+
+// ReplayWebhookNotification mocks base method.
+func (m *MockOrderUsecase) ReplayWebhookNotification(claims *helper.JWTCustomClaims, ctx echo.Context, notification *model.WebhookNotification) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayWebhookNotification", claims, ctx, notification)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// ReplayWebhookNotification indicates an expected call of ReplayWebhookNotification.
+func (mr *MockOrderUsecaseMockRecorder) ReplayWebhookNotification(claims, ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayWebhookNotification", reflect.TypeOf((*MockOrderUsecase)(nil).ReplayWebhookNotification), claims, ctx, notification)
+}
+
+// UploadReceipt mocks base method.
+func (m *MockOrderUsecase) UploadReceipt(ctx echo.Context, order *model.Order, payment *model.Payment) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadReceipt", ctx, order, payment)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// UploadReceipt indicates an expected call of UploadReceipt.
+func (mr *MockOrderUsecaseMockRecorder) UploadReceipt(ctx, order, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadReceipt", reflect.TypeOf((*MockOrderUsecase)(nil).UploadReceipt), ctx, order, payment)
+}
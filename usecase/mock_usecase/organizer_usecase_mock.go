@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/organizer_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrganizerUsecase is a mock of OrganizerUsecase interface.
+type MockOrganizerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizerUsecaseMockRecorder
+}
+
+// MockOrganizerUsecaseMockRecorder is the mock recorder for MockOrganizerUsecase.
+type MockOrganizerUsecaseMockRecorder struct {
+	mock *MockOrganizerUsecase
+}
+
+// NewMockOrganizerUsecase creates a new mock instance.
+func NewMockOrganizerUsecase(ctrl *gomock.Controller) *MockOrganizerUsecase {
+	mock := &MockOrganizerUsecase{ctrl: ctrl}
+	mock.recorder = &MockOrganizerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizerUsecase) EXPECT() *MockOrganizerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// FindOrganizer mocks base method.
+func (m *MockOrganizerUsecase) FindOrganizer(organizer *model.Organizer, id string) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrganizer", organizer, id)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// FindOrganizer indicates an expected call of FindOrganizer.
+func (mr *MockOrganizerUsecaseMockRecorder) FindOrganizer(organizer, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrganizer", reflect.TypeOf((*MockOrganizerUsecase)(nil).FindOrganizer), organizer, id)
+}
@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/payout_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	request "github.com/andikabahari/eoplatform/request"
+	gomock "github.com/golang/mock/gomock"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockPayoutUsecase is a mock of PayoutUsecase interface.
+type MockPayoutUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayoutUsecaseMockRecorder
+}
+
+// MockPayoutUsecaseMockRecorder is the mock recorder for MockPayoutUsecase.
+type MockPayoutUsecaseMockRecorder struct {
+	mock *MockPayoutUsecase
+}
+
+// NewMockPayoutUsecase creates a new mock instance.
+func NewMockPayoutUsecase(ctrl *gomock.Controller) *MockPayoutUsecase {
+	mock := &MockPayoutUsecase{ctrl: ctrl}
+	mock.recorder = &MockPayoutUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayoutUsecase) EXPECT() *MockPayoutUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CompleteOrRejectPayout mocks base method.
+func (m *MockPayoutUsecase) CompleteOrRejectPayout(claims *helper.JWTCustomClaims, ctx echo.Context, payout *model.Payout) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOrRejectPayout", claims, ctx, payout)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// CompleteOrRejectPayout indicates an expected call of CompleteOrRejectPayout.
+func (mr *MockPayoutUsecaseMockRecorder) CompleteOrRejectPayout(claims, ctx, payout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOrRejectPayout", reflect.TypeOf((*MockPayoutUsecase)(nil).CompleteOrRejectPayout), claims, ctx, payout)
+}
+
+// CreatePayout mocks base method.
+func (m *MockPayoutUsecase) CreatePayout(claims *helper.JWTCustomClaims, payout *model.Payout, req *request.CreatePayoutRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayout", claims, payout, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// CreatePayout indicates an expected call of CreatePayout.
+func (mr *MockPayoutUsecaseMockRecorder) CreatePayout(claims, payout, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayout", reflect.TypeOf((*MockPayoutUsecase)(nil).CreatePayout), claims, payout, req)
+}
+
+// GetPayouts mocks base method.
+func (m *MockPayoutUsecase) GetPayouts(claims *helper.JWTCustomClaims, payouts *[]model.Payout, status string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPayouts", claims, payouts, status)
+}
+
+// GetPayouts indicates an expected call of GetPayouts.
+func (mr *MockPayoutUsecaseMockRecorder) GetPayouts(claims, payouts, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayouts", reflect.TypeOf((*MockPayoutUsecase)(nil).GetPayouts), claims, payouts, status)
+}
@@ -0,0 +1,62 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/related_service_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRelatedServiceUsecase is a mock of RelatedServiceUsecase interface.
+type MockRelatedServiceUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelatedServiceUsecaseMockRecorder
+}
+
+// MockRelatedServiceUsecaseMockRecorder is the mock recorder for MockRelatedServiceUsecase.
+type MockRelatedServiceUsecaseMockRecorder struct {
+	mock *MockRelatedServiceUsecase
+}
+
+// NewMockRelatedServiceUsecase creates a new mock instance.
+func NewMockRelatedServiceUsecase(ctrl *gomock.Controller) *MockRelatedServiceUsecase {
+	mock := &MockRelatedServiceUsecase{ctrl: ctrl}
+	mock.recorder = &MockRelatedServiceUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelatedServiceUsecase) EXPECT() *MockRelatedServiceUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetRelatedServices mocks base method.
+func (m *MockRelatedServiceUsecase) GetRelatedServices(related *model.RelatedServices, id string) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelatedServices", related, id)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// GetRelatedServices indicates an expected call of GetRelatedServices.
+func (mr *MockRelatedServiceUsecaseMockRecorder) GetRelatedServices(related, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelatedServices", reflect.TypeOf((*MockRelatedServiceUsecase)(nil).GetRelatedServices), related, id)
+}
+
+// RefreshCooccurrences mocks base method.
+func (m *MockRelatedServiceUsecase) RefreshCooccurrences() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RefreshCooccurrences")
+}
+
+// RefreshCooccurrences indicates an expected call of RefreshCooccurrences.
+func (mr *MockRelatedServiceUsecaseMockRecorder) RefreshCooccurrences() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCooccurrences", reflect.TypeOf((*MockRelatedServiceUsecase)(nil).RefreshCooccurrences))
+}
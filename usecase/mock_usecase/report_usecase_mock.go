@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/report_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReportUsecase is a mock of ReportUsecase interface.
+type MockReportUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportUsecaseMockRecorder
+}
+
+// MockReportUsecaseMockRecorder is the mock recorder for MockReportUsecase.
+type MockReportUsecaseMockRecorder struct {
+	mock *MockReportUsecase
+}
+
+// NewMockReportUsecase creates a new mock instance.
+func NewMockReportUsecase(ctrl *gomock.Controller) *MockReportUsecase {
+	mock := &MockReportUsecase{ctrl: ctrl}
+	mock.recorder = &MockReportUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportUsecase) EXPECT() *MockReportUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetRevenue mocks base method.
+func (m *MockReportUsecase) GetRevenue(claims *helper.JWTCustomClaims, revenues *[]model.Revenue, from, to string) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevenue", claims, revenues, from, to)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// GetRevenue indicates an expected call of GetRevenue.
+func (mr *MockReportUsecaseMockRecorder) GetRevenue(claims, revenues, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevenue", reflect.TypeOf((*MockReportUsecase)(nil).GetRevenue), claims, revenues, from, to)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/service_image_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	request "github.com/andikabahari/eoplatform/request"
+	gomock "github.com/golang/mock/gomock"
+	echo "github.com/labstack/echo/v4"
+)
+
+// MockServiceImageUsecase is a mock of ServiceImageUsecase interface.
+type MockServiceImageUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceImageUsecaseMockRecorder
+}
+
+// MockServiceImageUsecaseMockRecorder is the mock recorder for MockServiceImageUsecase.
+type MockServiceImageUsecaseMockRecorder struct {
+	mock *MockServiceImageUsecase
+}
+
+// NewMockServiceImageUsecase creates a new mock instance.
+func NewMockServiceImageUsecase(ctrl *gomock.Controller) *MockServiceImageUsecase {
+	mock := &MockServiceImageUsecase{ctrl: ctrl}
+	mock.recorder = &MockServiceImageUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceImageUsecase) EXPECT() *MockServiceImageUsecaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteServiceImage mocks base method.
+func (m *MockServiceImageUsecase) DeleteServiceImage(ctx echo.Context, image *model.ServiceImage) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceImage", ctx, image)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// DeleteServiceImage indicates an expected call of DeleteServiceImage.
+func (mr *MockServiceImageUsecaseMockRecorder) DeleteServiceImage(ctx, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceImage", reflect.TypeOf((*MockServiceImageUsecase)(nil).DeleteServiceImage), ctx, image)
+}
+
+// ReorderServiceImages mocks base method.
+func (m *MockServiceImageUsecase) ReorderServiceImages(ctx echo.Context, images *[]model.ServiceImage, req *request.ReorderServiceImagesRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderServiceImages", ctx, images, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// ReorderServiceImages indicates an expected call of ReorderServiceImages.
+func (mr *MockServiceImageUsecaseMockRecorder) ReorderServiceImages(ctx, images, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderServiceImages", reflect.TypeOf((*MockServiceImageUsecase)(nil).ReorderServiceImages), ctx, images, req)
+}
+
+// UpdateServiceImage mocks base method.
+func (m *MockServiceImageUsecase) UpdateServiceImage(ctx echo.Context, image *model.ServiceImage, req *request.UpdateServiceImageRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceImage", ctx, image, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// UpdateServiceImage indicates an expected call of UpdateServiceImage.
+func (mr *MockServiceImageUsecaseMockRecorder) UpdateServiceImage(ctx, image, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceImage", reflect.TypeOf((*MockServiceImageUsecase)(nil).UpdateServiceImage), ctx, image, req)
+}
+
+// UploadServiceImage mocks base method.
+func (m *MockServiceImageUsecase) UploadServiceImage(ctx echo.Context, image *model.ServiceImage) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadServiceImage", ctx, image)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// UploadServiceImage indicates an expected call of UploadServiceImage.
+func (mr *MockServiceImageUsecaseMockRecorder) UploadServiceImage(ctx, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadServiceImage", reflect.TypeOf((*MockServiceImageUsecase)(nil).UploadServiceImage), ctx, image)
+}
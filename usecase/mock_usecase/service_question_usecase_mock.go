@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/service_question_usecase.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	reflect "reflect"
+
+	helper "github.com/andikabahari/eoplatform/helper"
+	model "github.com/andikabahari/eoplatform/model"
+	request "github.com/andikabahari/eoplatform/request"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockServiceQuestionUsecase is a mock of ServiceQuestionUsecase interface.
+type MockServiceQuestionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceQuestionUsecaseMockRecorder
+}
+
+// MockServiceQuestionUsecaseMockRecorder is the mock recorder for MockServiceQuestionUsecase.
+type MockServiceQuestionUsecaseMockRecorder struct {
+	mock *MockServiceQuestionUsecase
+}
+
+// NewMockServiceQuestionUsecase creates a new mock instance.
+func NewMockServiceQuestionUsecase(ctrl *gomock.Controller) *MockServiceQuestionUsecase {
+	mock := &MockServiceQuestionUsecase{ctrl: ctrl}
+	mock.recorder = &MockServiceQuestionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceQuestionUsecase) EXPECT() *MockServiceQuestionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AnswerQuestion mocks base method.
+func (m *MockServiceQuestionUsecase) AnswerQuestion(claims *helper.JWTCustomClaims, question *model.ServiceQuestion, serviceID, id string, req *request.AnswerServiceQuestionRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnswerQuestion", claims, question, serviceID, id, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// AnswerQuestion indicates an expected call of AnswerQuestion.
+func (mr *MockServiceQuestionUsecaseMockRecorder) AnswerQuestion(claims, question, serviceID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnswerQuestion", reflect.TypeOf((*MockServiceQuestionUsecase)(nil).AnswerQuestion), claims, question, serviceID, id, req)
+}
+
+// AskQuestion mocks base method.
+func (m *MockServiceQuestionUsecase) AskQuestion(claims *helper.JWTCustomClaims, question *model.ServiceQuestion, serviceID string, req *request.CreateServiceQuestionRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AskQuestion", claims, question, serviceID, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// AskQuestion indicates an expected call of AskQuestion.
+func (mr *MockServiceQuestionUsecaseMockRecorder) AskQuestion(claims, question, serviceID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskQuestion", reflect.TypeOf((*MockServiceQuestionUsecase)(nil).AskQuestion), claims, question, serviceID, req)
+}
+
+// GetQuestions mocks base method.
+func (m *MockServiceQuestionUsecase) GetQuestions(questions *[]model.ServiceQuestion, serviceID string) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestions", questions, serviceID)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// GetQuestions indicates an expected call of GetQuestions.
+func (mr *MockServiceQuestionUsecaseMockRecorder) GetQuestions(questions, serviceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestions", reflect.TypeOf((*MockServiceQuestionUsecase)(nil).GetQuestions), questions, serviceID)
+}
+
+// ModerateQuestion mocks base method.
+func (m *MockServiceQuestionUsecase) ModerateQuestion(claims *helper.JWTCustomClaims, question *model.ServiceQuestion, serviceID, id string, req *request.ModerateServiceQuestionRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateQuestion", claims, question, serviceID, id, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// ModerateQuestion indicates an expected call of ModerateQuestion.
+func (mr *MockServiceQuestionUsecaseMockRecorder) ModerateQuestion(claims, question, serviceID, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateQuestion", reflect.TypeOf((*MockServiceQuestionUsecase)(nil).ModerateQuestion), claims, question, serviceID, id, req)
+}
@@ -38,9 +38,11 @@ func (m *MockServiceUsecase) EXPECT() *MockServiceUsecaseMockRecorder {
 }
 
 // CreateService mocks base method.
-func (m *MockServiceUsecase) CreateService(claims *helper.JWTCustomClaims, service *model.Service, req *request.CreateServiceRequest) {
+func (m *MockServiceUsecase) CreateService(claims *helper.JWTCustomClaims, service *model.Service, req *request.CreateServiceRequest) helper.APIError {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "CreateService", claims, service, req)
+	ret := m.ctrl.Call(m, "CreateService", claims, service, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
 }
 
 // CreateService indicates an expected call of CreateService.
@@ -77,16 +79,70 @@ func (mr *MockServiceUsecaseMockRecorder) FindService(service, id interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindService", reflect.TypeOf((*MockServiceUsecase)(nil).FindService), service, id)
 }
 
+// GetOwnServices mocks base method.
+func (m *MockServiceUsecase) GetOwnServices(claims *helper.JWTCustomClaims, services *[]model.Service, req *request.GetOwnServicesRequest) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetOwnServices", claims, services, req)
+}
+
+// GetOwnServices indicates an expected call of GetOwnServices.
+func (mr *MockServiceUsecaseMockRecorder) GetOwnServices(claims, services, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnServices", reflect.TypeOf((*MockServiceUsecase)(nil).GetOwnServices), claims, services, req)
+}
+
+// GetRevisions mocks base method.
+func (m *MockServiceUsecase) GetRevisions(claims *helper.JWTCustomClaims, revisions *[]model.ServiceRevision, id string) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", claims, revisions, id)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockServiceUsecaseMockRecorder) GetRevisions(claims, revisions, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockServiceUsecase)(nil).GetRevisions), claims, revisions, id)
+}
+
 // GetServices mocks base method.
-func (m *MockServiceUsecase) GetServices(services *[]model.Service, keyword string) {
+func (m *MockServiceUsecase) GetServices(services *[]model.Service, facets *model.ServiceFacets, req *request.GetServicesRequest) helper.APIError {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "GetServices", services, keyword)
+	ret := m.ctrl.Call(m, "GetServices", services, facets, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
 }
 
 // GetServices indicates an expected call of GetServices.
-func (mr *MockServiceUsecaseMockRecorder) GetServices(services, keyword interface{}) *gomock.Call {
+func (mr *MockServiceUsecaseMockRecorder) GetServices(services, facets, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServices", reflect.TypeOf((*MockServiceUsecase)(nil).GetServices), services, facets, req)
+}
+
+// ImportServices mocks base method.
+func (m *MockServiceUsecase) ImportServices(ctx echo.Context, result *model.ServiceImport, req *request.ImportServicesRequest) helper.APIError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportServices", ctx, result, req)
+	ret0, _ := ret[0].(helper.APIError)
+	return ret0
+}
+
+// ImportServices indicates an expected call of ImportServices.
+func (mr *MockServiceUsecaseMockRecorder) ImportServices(ctx, result, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportServices", reflect.TypeOf((*MockServiceUsecase)(nil).ImportServices), ctx, result, req)
+}
+
+// RebuildSearchIndex mocks base method.
+func (m *MockServiceUsecase) RebuildSearchIndex() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RebuildSearchIndex")
+}
+
+// RebuildSearchIndex indicates an expected call of RebuildSearchIndex.
+func (mr *MockServiceUsecaseMockRecorder) RebuildSearchIndex() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServices", reflect.TypeOf((*MockServiceUsecase)(nil).GetServices), services, keyword)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildSearchIndex", reflect.TypeOf((*MockServiceUsecase)(nil).RebuildSearchIndex))
 }
 
 // UpdateService mocks base method.
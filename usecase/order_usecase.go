@@ -1,16 +1,26 @@
 package usecase
 
 import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"log"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/andikabahari/eoplatform/config"
 	"github.com/andikabahari/eoplatform/helper"
 	"github.com/andikabahari/eoplatform/model"
 	r "github.com/andikabahari/eoplatform/repository"
 	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/response"
+	"github.com/andikabahari/eoplatform/storage"
 	"github.com/golang-jwt/jwt"
 	"github.com/labstack/echo/v4"
 )
@@ -18,32 +28,58 @@ import (
 type OrderUsecase interface {
 	GetOrders(claims *helper.JWTCustomClaims, orders *[]model.Order, payments *[]model.Payment)
 	CreateOrder(claims *helper.JWTCustomClaims, order *model.Order, req *request.CreateOrderRequest) helper.APIError
-	AcceptOrCompleteOrder(ctx echo.Context, order *model.Order) helper.APIError
+	AcceptOrCompleteOrder(ctx echo.Context, order *model.Order, payment *model.Payment) helper.APIError
 	CancelOrder(ctx echo.Context, order *model.Order) helper.APIError
 	PaymentStatus(req *request.MidtransTransactionNotificationRequest) helper.APIError
+	GetWebhookNotifications(claims *helper.JWTCustomClaims, notifications *[]model.WebhookNotification, status string) helper.APIError
+	ReplayWebhookNotification(claims *helper.JWTCustomClaims, ctx echo.Context, notification *model.WebhookNotification) helper.APIError
+	UploadReceipt(ctx echo.Context, order *model.Order, payment *model.Payment) helper.APIError
+	GetReceipt(ctx echo.Context, payment *model.Payment) (io.ReadCloser, helper.APIError)
+	ConfirmOrRejectReceipt(ctx echo.Context, order *model.Order, payment *model.Payment, req *request.ReviewReceiptRequest) helper.APIError
 }
 
 type orderUsecase struct {
-	orderRepository       r.OrderRepository
-	paymentRepository     r.PaymentRepository
-	userRepository        r.UserRepository
-	serviceRepository     r.ServiceRepository
-	bankAccountRepository r.BankAccountRepository
+	transactor                    r.Transactor
+	orderRepository               r.OrderRepository
+	paymentRepository             r.PaymentRepository
+	userRepository                r.UserRepository
+	serviceRepository             r.ServiceRepository
+	serviceRevisionRepository     r.ServiceRevisionRepository
+	ledgerRepository              r.LedgerRepository
+	commissionRateRepository      r.CommissionRateRepository
+	webhookNotificationRepository r.WebhookNotificationRepository
+	bankAccountRepository         r.BankAccountRepository
+	receiptStorage                storage.Storage
+	commissionConfig              config.CommissionConfig
 }
 
 func NewOrderUsecase(
+	transactor r.Transactor,
 	orderRepository r.OrderRepository,
 	paymentRepository r.PaymentRepository,
 	userRepository r.UserRepository,
 	serviceRepository r.ServiceRepository,
+	serviceRevisionRepository r.ServiceRevisionRepository,
+	ledgerRepository r.LedgerRepository,
+	commissionRateRepository r.CommissionRateRepository,
+	webhookNotificationRepository r.WebhookNotificationRepository,
 	bankAccountRepository r.BankAccountRepository,
+	receiptStorage storage.Storage,
+	commissionConfig config.CommissionConfig,
 ) OrderUsecase {
 	return &orderUsecase{
+		transactor,
 		orderRepository,
 		paymentRepository,
 		userRepository,
 		serviceRepository,
+		serviceRevisionRepository,
+		ledgerRepository,
+		commissionRateRepository,
+		webhookNotificationRepository,
 		bankAccountRepository,
+		receiptStorage,
+		commissionConfig,
 	}
 }
 
@@ -71,22 +107,58 @@ func (u *orderUsecase) CreateOrder(claims *helper.JWTCustomClaims, order *model.
 		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
 	}
 
+	selections := make([]request.OrderService, 0, len(req.ServiceIDs)+len(req.Services))
+	for _, id := range req.ServiceIDs {
+		selections = append(selections, request.OrderService{ServiceID: id})
+	}
+	selections = append(selections, req.Services...)
+
 	services := make([]model.Service, 0)
+	items := make([]model.OrderItem, 0)
 
 	first := model.Service{}
-	for i, id := range req.ServiceIDs {
+	seen := make(map[uint]bool)
+	for i, selection := range selections {
+		if seen[selection.ServiceID] {
+			return helper.NewAPIError(http.StatusBadRequest, "service ordered more than once")
+		}
+		seen[selection.ServiceID] = true
+
 		service := model.Service{}
-		u.serviceRepository.Find(&service, fmt.Sprintf("%d", id))
+		u.serviceRepository.Find(&service, fmt.Sprintf("%d", selection.ServiceID))
 
 		if i == 0 {
 			first = service
 		}
 
-		if service.ID == 0 || service.UserID != first.UserID {
+		if service.ID == 0 || service.UserID != first.UserID || service.Status != model.ServiceStatusPublished {
 			return helper.NewAPIError(http.StatusBadRequest, "cannot proceed your order")
 		}
 
+		item, apiError := newOrderItem(service, selection.VariantID)
+		if apiError != nil {
+			return apiError
+		}
+
+		addonItems, apiError := newAddonItems(service, selection.Addons)
+		if apiError != nil {
+			return apiError
+		}
+
 		services = append(services, service)
+		items = append(items, item)
+		items = append(items, addonItems...)
+	}
+
+	serviceIDs := make([]uint, 0, len(services))
+	for _, service := range services {
+		serviceIDs = append(serviceIDs, service.ID)
+	}
+	revisionIDs := u.serviceRevisionRepository.GetLatestIDs(serviceIDs)
+	for i := range items {
+		if revisionID, ok := revisionIDs[items[i].ServiceID]; ok {
+			items[i].ServiceRevisionID = &revisionID
+		}
 	}
 
 	user := model.User{}
@@ -101,8 +173,19 @@ func (u *orderUsecase) CreateOrder(claims *helper.JWTCustomClaims, order *model.
 	order.Email = req.Email
 	order.Address = req.Address
 	order.Note = req.Note
+	order.PaymentMethod = req.PaymentMethod
+	order.Bank = req.Bank
 	order.UserID = claims.ID
+	if order.PaymentMethod == "" {
+		order.PaymentMethod = "bank_transfer"
+	}
+	if order.PaymentMethod != "bank_transfer" {
+		order.Bank = ""
+	} else if order.Bank == "" {
+		order.Bank = config.LoadMidtransConfig().Bank
+	}
 	order.Services = services
+	order.Items = items
 
 	u.orderRepository.Create(order)
 
@@ -111,7 +194,77 @@ func (u *orderUsecase) CreateOrder(claims *helper.JWTCustomClaims, order *model.
 	return nil
 }
 
-func (u *orderUsecase) AcceptOrCompleteOrder(ctx echo.Context, order *model.Order) helper.APIError {
+// newOrderItem prices a service for an order. Services that come in
+// variants must be ordered with one of them.
+func newOrderItem(service model.Service, variantID *uint) (model.OrderItem, helper.APIError) {
+	item := model.OrderItem{
+		ServiceID: service.ID,
+		Kind:      model.OrderItemKindService,
+		Name:      service.Name,
+		Price:     service.Cost,
+		Quantity:  1,
+	}
+
+	if variantID == nil {
+		if len(service.Variants) > 0 {
+			return item, helper.NewAPIError(http.StatusBadRequest, "variant required")
+		}
+		return item, nil
+	}
+
+	for _, variant := range service.Variants {
+		if variant.ID == *variantID {
+			item.VariantID = &variant.ID
+			item.Kind = model.OrderItemKindVariant
+			item.Name = fmt.Sprintf("%s - %s", service.Name, variant.Name)
+			item.Price = variant.Price
+			return item, nil
+		}
+	}
+
+	return item, helper.NewAPIError(http.StatusBadRequest, "variant not found")
+}
+
+// newAddonItems prices the add-ons selected for a service, keeping each
+// within its quantity limit.
+func newAddonItems(service model.Service, reqs []request.OrderAddon) ([]model.OrderItem, helper.APIError) {
+	addons := make(map[uint]model.ServiceAddon)
+	for _, addon := range service.Addons {
+		addons[addon.ID] = addon
+	}
+
+	items := make([]model.OrderItem, 0, len(reqs))
+	seen := make(map[uint]bool)
+	for _, req := range reqs {
+		addon, ok := addons[req.AddonID]
+		if !ok {
+			return nil, helper.NewAPIError(http.StatusBadRequest, "add-on not found")
+		}
+
+		if seen[addon.ID] {
+			return nil, helper.NewAPIError(http.StatusBadRequest, "add-on selected more than once")
+		}
+		seen[addon.ID] = true
+
+		if req.Quantity < 1 || req.Quantity > addon.MaxQuantity {
+			return nil, helper.NewAPIError(http.StatusBadRequest, fmt.Sprintf("%s can be ordered at most %d times", addon.Name, addon.MaxQuantity))
+		}
+
+		addonID := addon.ID
+		items = append(items, model.OrderItem{
+			ServiceID: service.ID,
+			AddonID:   &addonID,
+			Kind:      model.OrderItemKindAddon,
+			Name:      fmt.Sprintf("%s - %s", service.Name, addon.Name),
+			Price:     addon.Price,
+			Quantity:  req.Quantity,
+		})
+	}
+
+	return items, nil
+}
+
+func (u *orderUsecase) AcceptOrCompleteOrder(ctx echo.Context, order *model.Order, payment *model.Payment) helper.APIError {
 	u.orderRepository.Find(order, ctx.Param("id"))
 
 	if order.ID == 0 {
@@ -127,44 +280,70 @@ func (u *orderUsecase) AcceptOrCompleteOrder(ctx echo.Context, order *model.Orde
 
 	segment := strings.Split(ctx.Path(), "/")[4]
 	if segment == "accept" && !order.IsAccepted {
-		order.IsAccepted = true
+		totalCost := order.TotalCost()
 
-		var totalCost float64
-		for _, service := range order.Services {
-			totalCost += service.Cost
-		}
-
-		payment := model.Payment{}
 		payment.OrderID = order.ID
 		payment.Amount = totalCost
 		payment.Status = "pending"
-		u.paymentRepository.Create(&payment)
-
-		bankAccount := model.BankAccount{}
-		u.bankAccountRepository.FindByUserID(&bankAccount, claims.ID)
-
-		transaction := map[string]any{
-			"payment_type": "bank_transfer",
-			"transaction_details": map[string]any{
-				"order_id":     fmt.Sprintf("EOP-%d", order.ID),
-				"gross_amount": totalCost,
-			},
-			"bank_transfer": map[string]any{
-				"bank":      bankAccount.Bank,
-				"va_number": bankAccount.VANumber,
-			},
-			"customer_details": map[string]any{
-				"first_name": order.FirstName,
-				"last_name":  order.LastName,
-				"phone":      order.Phone,
-				"email":      order.Email,
-				"address":    order.Address,
-			},
-		}
-		helper.ChargeOrder(transaction)
-	}
-	if segment == "complete" && order.IsAccepted {
-		order.IsCompleted = true
+
+		if order.PaymentMethod == "manual" {
+			bankAccount := model.BankAccount{}
+			u.bankAccountRepository.FindByUserID(&bankAccount, claims.ID)
+
+			if bankAccount.ID == 0 {
+				return helper.NewAPIError(http.StatusBadRequest, "bank account required for manual payment")
+			}
+
+			payment.Method = "manual"
+			payment.Bank = bankAccount.Bank
+			payment.VANumber = bankAccount.VANumber
+		} else if config.LoadMidtransConfig().Checkout == "snap" {
+			res, err := helper.CreateSnapTransaction(newTransaction(order, totalCost))
+			if err != nil {
+				log.Printf("Error: %s", err)
+				return helper.NewAPIError(http.StatusBadGateway, "payment gateway error")
+			}
+
+			payment.Method = "snap"
+			payment.SnapToken = res.Token
+			payment.RedirectURL = res.RedirectURL
+		} else {
+			res, err := helper.ChargeOrder(newChargeRequest(order, totalCost))
+			if err != nil {
+				log.Printf("Error: %s", err)
+				return helper.NewAPIError(http.StatusBadGateway, "payment gateway error")
+			}
+
+			payment.Method = order.PaymentMethod
+			payment.Bank = order.Bank
+			applyChargeResponse(payment, res)
+		}
+
+		order.IsAccepted = true
+		u.paymentRepository.Create(payment)
+	}
+	if segment == "complete" && order.IsAccepted && !order.IsCompleted {
+		err := u.transactor.Transaction(func(tx r.Tx) error {
+			txUsecase := u.withTx(tx)
+
+			if err := txUsecase.paymentRepository.LockByOrderID(payment, order.ID); err != nil {
+				return err
+			}
+
+			order.IsCompleted = true
+			if payment.Status == "success" {
+				if err := txUsecase.releaseEscrow(order, payment); err != nil {
+					return err
+				}
+			}
+
+			return txUsecase.orderRepository.Save(order)
+		})
+		if err != nil {
+			return transactionError(err)
+		}
+
+		return nil
 	}
 
 	u.orderRepository.Save(order)
@@ -186,32 +365,571 @@ func (u *orderUsecase) CancelOrder(ctx echo.Context, order *model.Order) helper.
 		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	u.orderRepository.Delete(order)
+	// Once the organizer accepts the order, the customer has been asked to
+	// pay for it and may already have, so it can no longer be cancelled.
+	payment := model.Payment{}
+	u.paymentRepository.FindOnlyByOrderID(&payment, order.ID)
+
+	if order.IsAccepted || payment.OrderID != 0 {
+		return helper.NewAPIError(http.StatusBadRequest, "order already accepted")
+	}
+
+	deleted, err := u.orderRepository.DeleteUnaccepted(order)
+	if err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+
+	if !deleted {
+		return helper.NewAPIError(http.StatusBadRequest, "order already accepted")
+	}
+
+	return nil
+}
+
+const maxReceiptSize = 5 << 20
+
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+func (u *orderUsecase) UploadReceipt(ctx echo.Context, order *model.Order, payment *model.Payment) helper.APIError {
+	u.orderRepository.Find(order, ctx.Param("id"))
+
+	if order.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "order not found")
+	}
+
+	userToken := ctx.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if order.UserID != claims.ID {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.paymentRepository.FindOnlyByOrderID(payment, order.ID)
+
+	if payment.ID == 0 || payment.Method != "manual" {
+		return helper.NewAPIError(http.StatusBadRequest, "order is not awaiting manual payment")
+	}
+
+	if payment.Status != "pending" && payment.Status != "rejected" {
+		return helper.NewAPIError(http.StatusBadRequest, "receipt already submitted")
+	}
+
+	fileHeader, err := ctx.FormFile("receipt")
+	if err != nil {
+		return helper.NewAPIError(http.StatusBadRequest, "receipt is required")
+	}
+
+	if fileHeader.Size > maxReceiptSize {
+		return helper.NewAPIError(http.StatusBadRequest, "receipt must not exceed 5MB")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+	defer file.Close()
+
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(file, head)
+	contentType := http.DetectContentType(head[:n])
+
+	extension, ok := imageExtensions[contentType]
+	if !ok {
+		return helper.NewAPIError(http.StatusBadRequest, "receipt must be a JPEG or PNG image")
+	}
+
+	// Receipts are kept in private storage and only served by GetReceipt.
+	key := fmt.Sprintf("receipts/%d-%d%s", order.ID, time.Now().UnixNano(), extension)
+	if _, err := u.receiptStorage.Put(key, io.MultiReader(bytes.NewReader(head[:n]), file), contentType); err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+
+	if payment.ReceiptKey != "" {
+		if err := u.receiptStorage.Delete(payment.ReceiptKey); err != nil {
+			log.Printf("Error: %s", err)
+		}
+	}
+
+	payment.ReceiptKey = key
+	payment.ReceiptURL = fmt.Sprintf("/v1/orders/%d/receipt", order.ID)
+	payment.ReceiptNote = ""
+	payment.Status = "review"
+	u.paymentRepository.Save(payment)
+
+	return nil
+}
+
+// GetReceipt opens the receipt uploaded for an order, which only its
+// customer and organizer may see. The caller must close it.
+func (u *orderUsecase) GetReceipt(ctx echo.Context, payment *model.Payment) (io.ReadCloser, helper.APIError) {
+	order := model.Order{}
+	u.orderRepository.Find(&order, ctx.Param("id"))
+
+	if order.ID == 0 {
+		return nil, helper.NewAPIError(http.StatusNotFound, "order not found")
+	}
+
+	userToken := ctx.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if order.UserID != claims.ID && order.Services[0].UserID != claims.ID {
+		return nil, helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.paymentRepository.FindOnlyByOrderID(payment, order.ID)
+
+	if payment.ReceiptKey == "" {
+		return nil, helper.NewAPIError(http.StatusNotFound, "receipt not found")
+	}
+
+	body, err := u.receiptStorage.Get(payment.ReceiptKey)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, helper.NewAPIError(http.StatusNotFound, "receipt not found")
+	}
+	if err != nil {
+		log.Printf("Error: %s", err)
+		return nil, helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+
+	return body, nil
+}
+
+func (u *orderUsecase) ConfirmOrRejectReceipt(ctx echo.Context, order *model.Order, payment *model.Payment, req *request.ReviewReceiptRequest) helper.APIError {
+	u.orderRepository.Find(order, ctx.Param("id"))
+
+	if order.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "order not found")
+	}
+
+	userToken := ctx.Get("user").(*jwt.Token)
+	claims := userToken.Claims.(*helper.JWTCustomClaims)
+
+	if order.Services[0].UserID != claims.ID {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	segment := strings.Split(ctx.Path(), "/")[5]
+
+	err := u.transactor.Transaction(func(tx r.Tx) error {
+		txUsecase := u.withTx(tx)
+
+		if err := txUsecase.paymentRepository.LockByOrderID(payment, order.ID); err != nil {
+			return err
+		}
+
+		if payment.Method != "manual" || payment.Status != "review" {
+			return helper.NewAPIError(http.StatusBadRequest, "no receipt to review")
+		}
+
+		if segment == "confirm" {
+			// The order may have completed while the payment was unlocked.
+			txUsecase.orderRepository.Find(order, ctx.Param("id"))
+
+			payment.Status = "success"
+			if err := txUsecase.settlePayment(order, payment); err != nil {
+				return err
+			}
+
+			if order.IsCompleted {
+				if err := txUsecase.releaseEscrow(order, payment); err != nil {
+					return err
+				}
+			}
+		}
+		if segment == "reject" {
+			payment.Status = "rejected"
+			payment.ReceiptNote = req.Note
+		}
+
+		return txUsecase.paymentRepository.Save(payment)
+	})
+	if err != nil {
+		return transactionError(err)
+	}
 
 	return nil
 }
 
 func (u *orderUsecase) PaymentStatus(req *request.MidtransTransactionNotificationRequest) helper.APIError {
-	orderID := strings.Split(req.OrderID, "-")[1]
+	// Anyone can call the webhook, so a notification is only trusted when
+	// Midtrans signed it with the server key and it is for the amount the
+	// order was charged.
+	signature := helper.MidtransSignature(req.OrderID, req.StatusCode, req.GrossAmount, config.LoadMidtransConfig().ServerKey)
+	if subtle.ConstantTimeCompare([]byte(signature), []byte(req.SignatureKey)) != 1 {
+		return helper.NewAPIError(http.StatusUnauthorized, "invalid signature")
+	}
 
 	payment := model.Payment{}
-	u.paymentRepository.FindOnlyByOrderID(&payment, orderID)
+	if parts := strings.Split(req.OrderID, "-"); len(parts) == 2 {
+		u.paymentRepository.FindOnlyByOrderID(&payment, parts[1])
+	}
 
 	if payment.OrderID == 0 {
 		return helper.NewAPIError(http.StatusNotFound, "order not found")
 	}
 
+	grossAmount, err := strconv.ParseFloat(req.GrossAmount, 64)
+	if err != nil || model.Money(math.Round(grossAmount)) != payment.Amount {
+		return helper.NewAPIError(http.StatusBadRequest, "gross amount does not match")
+	}
+
+	transactionID := req.TransactionID
+	if transactionID == "" {
+		transactionID = req.OrderID
+	}
+
+	notification := model.WebhookNotification{
+		TransactionID:     transactionID,
+		TransactionStatus: req.Status,
+		OrderID:           req.OrderID,
+		PaymentType:       req.PaymentType,
+		Status:            model.WebhookStatusReceived,
+	}
+
+	// Midtrans retries until it gets a 2xx, and may deliver a notification
+	// again while the first delivery is still being handled. It is applied
+	// by the delivery that records it, or that claims it back after it
+	// failed; the others are acknowledged without applying it again.
+	created, err := u.webhookNotificationRepository.Create(&notification)
+	if err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+
+	if !created {
+		notification = model.WebhookNotification{}
+		u.webhookNotificationRepository.FindByKey(&notification, transactionID, req.Status)
+
+		if notification.Status != model.WebhookStatusFailed || !u.webhookNotificationRepository.Claim(&notification) {
+			return nil
+		}
+	}
+
+	return u.processNotification(&notification)
+}
+
+func (u *orderUsecase) GetWebhookNotifications(claims *helper.JWTCustomClaims, notifications *[]model.WebhookNotification, status string) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.webhookNotificationRepository.Get(notifications, status)
+
+	return nil
+}
+
+func (u *orderUsecase) ReplayWebhookNotification(claims *helper.JWTCustomClaims, ctx echo.Context, notification *model.WebhookNotification) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.webhookNotificationRepository.Find(notification, ctx.Param("id"))
+
+	if notification.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "notification not found")
+	}
+
+	if notification.Status != model.WebhookStatusFailed || !u.webhookNotificationRepository.Claim(notification) {
+		return helper.NewAPIError(http.StatusBadRequest, "notification has not failed")
+	}
+
+	return u.processNotification(notification)
+}
+
+func (u *orderUsecase) processNotification(notification *model.WebhookNotification) helper.APIError {
+	applied, apiError := u.applyPaymentStatus(&request.MidtransTransactionNotificationRequest{
+		TransactionID: notification.TransactionID,
+		OrderID:       notification.OrderID,
+		Status:        notification.TransactionStatus,
+		PaymentType:   notification.PaymentType,
+	})
+
+	now := time.Now()
+	notification.Attempts++
+	notification.ProcessedAt = &now
+	notification.Error = ""
+	switch {
+	case apiError != nil:
+		_, message := apiError.APIError()
+		notification.Status = model.WebhookStatusFailed
+		notification.Error = message
+	case applied:
+		notification.Status = model.WebhookStatusProcessed
+	default:
+		notification.Status = model.WebhookStatusIgnored
+	}
+	u.webhookNotificationRepository.Save(notification)
+
+	return apiError
+}
+
+// paymentStatusRank orders payment statuses so that a retried or late
+// notification, such as pending after settlement, never moves a payment
+// backwards. Success and fail are both final.
+var paymentStatusRank = map[string]int{
+	"pending": 0,
+	"success": 1,
+	"fail":    1,
+}
+
+func (u *orderUsecase) applyPaymentStatus(req *request.MidtransTransactionNotificationRequest) (bool, helper.APIError) {
+	parts := strings.Split(req.OrderID, "-")
+	if len(parts) != 2 {
+		return false, helper.NewAPIError(http.StatusNotFound, "order not found")
+	}
+	orderID := parts[1]
+
 	if req.Status == "settlement" || req.Status == "capture" {
 		req.Status = "success"
 	}
 	if req.Status == "deny" || req.Status == "cancel" || req.Status == "expire" {
 		req.Status = "fail"
+	}
+
+	applied := false
+	err := u.transactor.Transaction(func(tx r.Tx) error {
+		txUsecase := u.withTx(tx)
 
-		order := model.Order{}
-		u.orderRepository.FindOnly(&order, orderID)
-		u.orderRepository.Delete(&order)
+		payment := model.Payment{}
+		if err := txUsecase.paymentRepository.LockByOrderID(&payment, orderID); err != nil {
+			return err
+		}
+
+		if payment.OrderID == 0 {
+			return helper.NewAPIError(http.StatusNotFound, "order not found")
+		}
+
+		rank, ok := paymentStatusRank[req.Status]
+		if !ok || rank <= paymentStatusRank[payment.Status] {
+			return nil
+		}
+
+		if req.Status == "success" {
+			order := model.Order{}
+			txUsecase.orderRepository.Find(&order, orderID)
+
+			if order.ID == 0 {
+				return helper.NewAPIError(http.StatusNotFound, "order not found")
+			}
+
+			if err := txUsecase.settlePayment(&order, &payment); err != nil {
+				return err
+			}
+
+			if order.IsCompleted {
+				if err := txUsecase.releaseEscrow(&order, &payment); err != nil {
+					return err
+				}
+			}
+		}
+		if req.Status == "fail" {
+			order := model.Order{}
+			txUsecase.orderRepository.FindOnly(&order, orderID)
+
+			if order.ID > 0 {
+				if err := txUsecase.orderRepository.Delete(&order); err != nil {
+					return err
+				}
+			}
+		}
+
+		applied = true
+
+		return txUsecase.paymentRepository.Update(&payment, req)
+	})
+	if err != nil {
+		return false, transactionError(err)
 	}
-	u.paymentRepository.Update(&payment, req)
 
-	return nil
+	return applied, nil
+}
+
+// settlePayment splits the settled payment of order into the platform
+// commission and the organizer's earning, and holds it in escrow until the
+// order completes.
+func (u *orderUsecase) settlePayment(order *model.Order, payment *model.Payment) error {
+	services := make(map[uint]model.Service)
+	for _, service := range order.Services {
+		services[service.ID] = service
+	}
+
+	var commission model.Money
+	for _, line := range order.Lines() {
+		commission += line.Total().Mul(u.commissionRate(services[line.ServiceID]))
+	}
+	if commission > payment.Amount {
+		commission = payment.Amount
+	}
+	payment.Commission = commission
+	payment.Earning = payment.Amount - payment.Commission
+
+	transaction := newLedgerTransfer(
+		model.LedgerKindPayment,
+		order.Services[0].UserID,
+		payment.Amount,
+		model.LedgerAccountGateway,
+		model.LedgerAccountEscrow,
+	)
+	transaction.OrderID = &order.ID
+
+	return u.ledgerRepository.Post(transaction)
+}
+
+// releaseEscrow pays the order's settled payment out of escrow, unless it
+// already was.
+func (u *orderUsecase) releaseEscrow(order *model.Order, payment *model.Payment) error {
+	released, err := u.ledgerRepository.HasTransaction(model.LedgerKindRelease, order.ID)
+	if err != nil || released {
+		return err
+	}
+
+	userID := order.Services[0].UserID
+	transaction := &model.LedgerTransaction{
+		Kind:    model.LedgerKindRelease,
+		OrderID: &order.ID,
+		Entries: []model.LedgerEntry{
+			{Account: model.LedgerAccountEscrow, UserID: userID, Amount: -payment.Amount},
+			{Account: model.LedgerAccountOrganizer, UserID: userID, Amount: payment.Earning},
+			{Account: model.LedgerAccountRevenue, UserID: userID, Amount: payment.Commission},
+		},
+	}
+
+	// A manual transfer was paid into the organizer's own bank account, so
+	// the whole amount counts as already disbursed to them and the
+	// commission is taken from their balance instead.
+	if payment.Method == "manual" {
+		transaction.Entries = append(transaction.Entries,
+			model.LedgerEntry{Account: model.LedgerAccountOrganizer, UserID: userID, Amount: -payment.Amount},
+			model.LedgerEntry{Account: model.LedgerAccountDisbursed, UserID: userID, Amount: payment.Amount},
+		)
+	}
+
+	return u.ledgerRepository.Post(transaction)
+}
+
+// withTx returns a copy of the usecase with its order, payment and ledger
+// repositories bound to tx.
+func (u *orderUsecase) withTx(tx r.Tx) *orderUsecase {
+	txUsecase := *u
+	txUsecase.orderRepository = u.orderRepository.WithTx(tx)
+	txUsecase.paymentRepository = u.paymentRepository.WithTx(tx)
+	txUsecase.ledgerRepository = u.ledgerRepository.WithTx(tx)
+
+	return &txUsecase
+}
+
+// commissionRate prefers an organizer override, then a rate set on the
+// service category, over the global default.
+func (u *orderUsecase) commissionRate(service model.Service) float64 {
+	rate := model.CommissionRate{}
+	u.commissionRateRepository.FindByScope(&rate, model.CommissionScopeOrganizer, service.UserID)
+	if rate.ID > 0 {
+		return rate.Rate
+	}
+
+	if service.CategoryID != nil {
+		u.commissionRateRepository.FindByScope(&rate, model.CommissionScopeCategory, *service.CategoryID)
+		if rate.ID > 0 {
+			return rate.Rate
+		}
+	}
+
+	return u.commissionConfig.Rate
+}
+
+func newTransaction(order *model.Order, totalCost model.Money) map[string]any {
+	return map[string]any{
+		"transaction_details": map[string]any{
+			"order_id":     fmt.Sprintf("EOP-%d", order.ID),
+			"gross_amount": totalCost,
+		},
+		"item_details": newItemDetails(order),
+		"customer_details": map[string]any{
+			"first_name": order.FirstName,
+			"last_name":  order.LastName,
+			"phone":      order.Phone,
+			"email":      order.Email,
+			"address":    order.Address,
+		},
+	}
+}
+
+// newItemDetails lists the order lines for Midtrans, which requires their
+// sum to equal gross_amount and item names of at most 50 characters.
+func newItemDetails(order *model.Order) []map[string]any {
+	items := make([]map[string]any, 0)
+	for _, line := range order.Lines() {
+		id := fmt.Sprintf("%s-%d", line.Kind, line.ServiceID)
+		if line.VariantID != nil {
+			id = fmt.Sprintf("%s-%d", line.Kind, *line.VariantID)
+		}
+		if line.AddonID != nil {
+			id = fmt.Sprintf("%s-%d", line.Kind, *line.AddonID)
+		}
+
+		name := []rune(line.Name)
+		if len(name) > 50 {
+			name = name[:50]
+		}
+
+		items = append(items, map[string]any{
+			"id":       id,
+			"price":    line.Price,
+			"quantity": line.Quantity,
+			"name":     string(name),
+		})
+	}
+
+	return items
+}
+
+func newChargeRequest(order *model.Order, totalCost model.Money) map[string]any {
+	transaction := newTransaction(order, totalCost)
+	transaction["payment_type"] = order.PaymentMethod
+
+	switch order.PaymentMethod {
+	case "echannel":
+		transaction["echannel"] = map[string]any{
+			"bill_info1": "Payment for:",
+			"bill_info2": fmt.Sprintf("EOP-%d", order.ID),
+		}
+	case "qris":
+		transaction["qris"] = map[string]any{
+			"acquirer": "gopay",
+		}
+	case "gopay":
+		// GoPay needs no method-specific parameters.
+	default:
+		transaction["payment_type"] = "bank_transfer"
+		transaction["bank_transfer"] = map[string]any{
+			"bank": order.Bank,
+		}
+	}
+
+	return transaction
+}
+
+func applyChargeResponse(payment *model.Payment, res *response.MidtransChargeResponse) {
+	payment.VANumber = res.PermataVANumber
+	for _, vaNumber := range res.VANumbers {
+		payment.VANumber = vaNumber.VANumber
+	}
+	payment.BillKey = res.BillKey
+	payment.BillerCode = res.BillerCode
+	payment.QRString = res.QRString
+	for _, action := range res.Actions {
+		switch action.Name {
+		case "generate-qr-code":
+			payment.QRURL = action.URL
+		case "deeplink-redirect":
+			payment.Deeplink = action.URL
+		}
+	}
+	payment.ExpiryTime = res.ExpiryTime
 }
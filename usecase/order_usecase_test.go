@@ -1,15 +1,25 @@
 package usecase
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/andikabahari/eoplatform/config"
 	"github.com/andikabahari/eoplatform/helper"
 	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
 	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
 	"github.com/andikabahari/eoplatform/request"
+	ms "github.com/andikabahari/eoplatform/storage/mock_storage"
 	"github.com/golang-jwt/jwt"
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
@@ -20,12 +30,18 @@ import (
 type orderUsecaseSuite struct {
 	suite.Suite
 
-	ctrl                  *gomock.Controller
-	orderRepository       *mr.MockOrderRepository
-	paymentRepository     *mr.MockPaymentRepository
-	userRepository        *mr.MockUserRepository
-	serviceRepository     *mr.MockServiceRepository
-	bankAccountRepository *mr.MockBankAccountRepository
+	ctrl                          *gomock.Controller
+	transactor                    *mr.MockTransactor
+	orderRepository               *mr.MockOrderRepository
+	paymentRepository             *mr.MockPaymentRepository
+	userRepository                *mr.MockUserRepository
+	serviceRepository             *mr.MockServiceRepository
+	serviceRevisionRepository     *mr.MockServiceRevisionRepository
+	ledgerRepository              *mr.MockLedgerRepository
+	commissionRateRepository      *mr.MockCommissionRateRepository
+	webhookNotificationRepository *mr.MockWebhookNotificationRepository
+	bankAccountRepository         *mr.MockBankAccountRepository
+	storage                       *ms.MockStorage
 
 	usecase OrderUsecase
 }
@@ -34,18 +50,31 @@ func (s *orderUsecaseSuite) SetupSuite() {
 	os.Setenv("APP_ENV", "production")
 
 	s.ctrl = gomock.NewController(s.T())
+	s.transactor = mr.NewMockTransactor(s.ctrl)
 	s.orderRepository = mr.NewMockOrderRepository(s.ctrl)
 	s.paymentRepository = mr.NewMockPaymentRepository(s.ctrl)
 	s.userRepository = mr.NewMockUserRepository(s.ctrl)
 	s.serviceRepository = mr.NewMockServiceRepository(s.ctrl)
+	s.serviceRevisionRepository = mr.NewMockServiceRevisionRepository(s.ctrl)
+	s.ledgerRepository = mr.NewMockLedgerRepository(s.ctrl)
+	s.commissionRateRepository = mr.NewMockCommissionRateRepository(s.ctrl)
+	s.webhookNotificationRepository = mr.NewMockWebhookNotificationRepository(s.ctrl)
 	s.bankAccountRepository = mr.NewMockBankAccountRepository(s.ctrl)
+	s.storage = ms.NewMockStorage(s.ctrl)
 
 	s.usecase = NewOrderUsecase(
+		s.transactor,
 		s.orderRepository,
 		s.paymentRepository,
 		s.userRepository,
 		s.serviceRepository,
+		s.serviceRevisionRepository,
+		s.ledgerRepository,
+		s.commissionRateRepository,
+		s.webhookNotificationRepository,
 		s.bankAccountRepository,
+		s.storage,
+		config.CommissionConfig{Rate: 0.1},
 	)
 }
 
@@ -57,6 +86,17 @@ func TestOrderUsecaseSuite(t *testing.T) {
 	suite.Run(t, new(orderUsecaseSuite))
 }
 
+// expectTransaction runs the usecase's transaction with the suite's mocks
+// bound to it.
+func (s *orderUsecaseSuite) expectTransaction() {
+	s.transactor.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(tx r.Tx) error) error {
+		return fn(r.Tx{})
+	})
+	s.orderRepository.EXPECT().WithTx(gomock.Any()).Return(s.orderRepository)
+	s.paymentRepository.EXPECT().WithTx(gomock.Any()).Return(s.paymentRepository)
+	s.ledgerRepository.EXPECT().WithTx(gomock.Any()).Return(s.ledgerRepository)
+}
+
 func (s *orderUsecaseSuite) TestGetOrders() {
 	testCases := []struct {
 		Name         string
@@ -105,6 +145,8 @@ func (s *orderUsecaseSuite) TestGetOrders() {
 }
 
 func (s *orderUsecaseSuite) TestCreateOrder() {
+	variantID, unknownVariantID := uint(4), uint(9)
+
 	testCases := []struct {
 		Name         string
 		Body         *request.CreateOrderRequest
@@ -150,14 +192,271 @@ func (s *orderUsecaseSuite) TestCreateOrder() {
 				s.serviceRepository.EXPECT().Find(
 					gomock.Eq(&model.Service{}),
 					gomock.Eq("1"),
-				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}})
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusPublished})
+
+				s.serviceRevisionRepository.EXPECT().GetLatestIDs(gomock.Eq([]uint{1})).Return(map[uint]uint{1: 7})
+
+				s.userRepository.EXPECT().Find(
+					gomock.Eq(&model.User{}),
+					gomock.Eq(uint(1)),
+				)
+
+				s.orderRepository.EXPECT().Create(gomock.Any()).Do(func(order *model.Order) {
+					s.Equal(uint(7), *order.Items[0].ServiceRevisionID)
+				})
+			},
+			http.StatusOK,
+		},
+		{
+			"archived service",
+			&request.CreateOrderRequest{
+				DateOfEvent: "2022-12-12",
+				FirstName:   "Example",
+				LastName:    "User",
+				Phone:       "08123456789",
+				Email:       "user@example.com",
+				Address:     "Mars",
+				Note:        "Ok.",
+				ServiceIDs:  []uint{1},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusArchived})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"duplicate service",
+			&request.CreateOrderRequest{
+				DateOfEvent: "2022-12-12",
+				FirstName:   "Example",
+				LastName:    "User",
+				Phone:       "08123456789",
+				Email:       "user@example.com",
+				Address:     "Mars",
+				Note:        "Ok.",
+				ServiceIDs:  []uint{1},
+				Services:    []request.OrderService{{ServiceID: 1}},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusPublished})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"variant required",
+			&request.CreateOrderRequest{
+				DateOfEvent: "2022-12-12",
+				FirstName:   "Example",
+				LastName:    "User",
+				Phone:       "08123456789",
+				Email:       "user@example.com",
+				Address:     "Mars",
+				Note:        "Ok.",
+				ServiceIDs:  []uint{1},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{
+					Model:  gorm.Model{ID: 1},
+					Name:   "Catering",
+					UserID: 2,
+					Status: model.ServiceStatusPublished,
+					Variants: []model.ServiceVariant{
+						{Model: gorm.Model{ID: 3}, Name: "Silver", Price: 500000},
+						{Model: gorm.Model{ID: 4}, Name: "Gold", Price: 900000},
+					},
+				})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"variant not found",
+			&request.CreateOrderRequest{
+				DateOfEvent: "2022-12-12",
+				FirstName:   "Example",
+				LastName:    "User",
+				Phone:       "08123456789",
+				Email:       "user@example.com",
+				Address:     "Mars",
+				Note:        "Ok.",
+				Services:    []request.OrderService{{ServiceID: 1, VariantID: &unknownVariantID}},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{
+					Model:  gorm.Model{ID: 1},
+					Name:   "Catering",
+					UserID: 2,
+					Status: model.ServiceStatusPublished,
+					Variants: []model.ServiceVariant{
+						{Model: gorm.Model{ID: 3}, Name: "Silver", Price: 500000},
+						{Model: gorm.Model{ID: 4}, Name: "Gold", Price: 900000},
+					},
+				})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok with variant",
+			&request.CreateOrderRequest{
+				DateOfEvent: "2022-12-12",
+				FirstName:   "Example",
+				LastName:    "User",
+				Phone:       "08123456789",
+				Email:       "user@example.com",
+				Address:     "Mars",
+				Note:        "Ok.",
+				Services:    []request.OrderService{{ServiceID: 1, VariantID: &variantID}},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{
+					Model:  gorm.Model{ID: 1},
+					Name:   "Catering",
+					UserID: 2,
+					Status: model.ServiceStatusPublished,
+					Variants: []model.ServiceVariant{
+						{Model: gorm.Model{ID: 3}, Name: "Silver", Price: 500000},
+						{Model: gorm.Model{ID: 4}, Name: "Gold", Price: 900000},
+					},
+				})
+
+				s.serviceRevisionRepository.EXPECT().GetLatestIDs(gomock.Eq([]uint{1}))
+
+				s.userRepository.EXPECT().Find(
+					gomock.Eq(&model.User{}),
+					gomock.Eq(uint(1)),
+				)
+
+				s.orderRepository.EXPECT().Create(gomock.Any()).Do(func(order *model.Order) {
+					s.Len(order.Items, 1)
+					s.Equal(model.OrderItemKindVariant, order.Items[0].Kind)
+					s.Equal("Catering - Gold", order.Items[0].Name)
+					s.Equal(model.Money(900000), order.Items[0].Price)
+					s.Equal(model.Money(900000), order.TotalCost())
+				})
+			},
+			http.StatusOK,
+		},
+		{
+			"add-on not found",
+			&request.CreateOrderRequest{
+				DateOfEvent: "2022-12-12",
+				FirstName:   "Example",
+				LastName:    "User",
+				Phone:       "08123456789",
+				Email:       "user@example.com",
+				Address:     "Mars",
+				Note:        "Ok.",
+				Services:    []request.OrderService{{ServiceID: 1, Addons: []request.OrderAddon{{AddonID: 7, Quantity: 1}}}},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{
+					Model:  gorm.Model{ID: 1},
+					Name:   "Photography",
+					UserID: 2,
+					Status: model.ServiceStatusPublished,
+					Cost:   1000000,
+					Addons: []model.ServiceAddon{
+						{Model: gorm.Model{ID: 6}, Name: "Extra hour", Price: 250000, MaxQuantity: 3},
+					},
+				})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"add-on quantity over limit",
+			&request.CreateOrderRequest{
+				DateOfEvent: "2022-12-12",
+				FirstName:   "Example",
+				LastName:    "User",
+				Phone:       "08123456789",
+				Email:       "user@example.com",
+				Address:     "Mars",
+				Note:        "Ok.",
+				Services:    []request.OrderService{{ServiceID: 1, Addons: []request.OrderAddon{{AddonID: 6, Quantity: 4}}}},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{
+					Model:  gorm.Model{ID: 1},
+					Name:   "Photography",
+					UserID: 2,
+					Status: model.ServiceStatusPublished,
+					Cost:   1000000,
+					Addons: []model.ServiceAddon{
+						{Model: gorm.Model{ID: 6}, Name: "Extra hour", Price: 250000, MaxQuantity: 3},
+					},
+				})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok with add-ons",
+			&request.CreateOrderRequest{
+				DateOfEvent: "2022-12-12",
+				FirstName:   "Example",
+				LastName:    "User",
+				Phone:       "08123456789",
+				Email:       "user@example.com",
+				Address:     "Mars",
+				Note:        "Ok.",
+				Services:    []request.OrderService{{ServiceID: 1, Addons: []request.OrderAddon{{AddonID: 6, Quantity: 2}}}},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "customer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{
+					Model:  gorm.Model{ID: 1},
+					Name:   "Photography",
+					UserID: 2,
+					Status: model.ServiceStatusPublished,
+					Cost:   1000000,
+					Addons: []model.ServiceAddon{
+						{Model: gorm.Model{ID: 6}, Name: "Extra hour", Price: 250000, MaxQuantity: 3},
+					},
+				})
+
+				s.serviceRevisionRepository.EXPECT().GetLatestIDs(gomock.Eq([]uint{1}))
 
 				s.userRepository.EXPECT().Find(
 					gomock.Eq(&model.User{}),
 					gomock.Eq(uint(1)),
 				)
 
-				s.orderRepository.EXPECT().Create(gomock.Any())
+				s.orderRepository.EXPECT().Create(gomock.Any()).Do(func(order *model.Order) {
+					s.Len(order.Items, 2)
+					s.Equal(model.OrderItemKindAddon, order.Items[1].Kind)
+					s.Equal("Photography - Extra hour", order.Items[1].Name)
+					s.Equal(2, order.Items[1].Quantity)
+					s.Equal(model.Money(1500000), order.TotalCost())
+				})
 			},
 			http.StatusOK,
 		},
@@ -175,6 +474,41 @@ func (s *orderUsecaseSuite) TestCreateOrder() {
 }
 
 func (s *orderUsecaseSuite) TestAcceptOrCompleteOrder() {
+	addonID := uint(5)
+
+	midtrans := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/snap/v1/transactions" {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"token":"66e4fa55-fdac-4ef9-91b5-733b97d1b862","redirect_url":"https://app.sandbox.midtrans.com/snap/v2/vtweb/66e4fa55-fdac-4ef9-91b5-733b97d1b862"}`))
+			return
+		}
+		if r.URL.Path == "/error/v2/charge" {
+			w.Write([]byte(`{"status_code":"500","status_message":"Internal Server Error"}`))
+			return
+		}
+		charge := struct {
+			TransactionDetails struct {
+				GrossAmount model.Money `json:"gross_amount"`
+			} `json:"transaction_details"`
+			ItemDetails []struct {
+				Price    model.Money `json:"price"`
+				Quantity int         `json:"quantity"`
+			} `json:"item_details"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&charge)
+		var sum model.Money
+		for _, item := range charge.ItemDetails {
+			sum += item.Price * model.Money(item.Quantity)
+		}
+		if sum != charge.TransactionDetails.GrossAmount {
+			w.Write([]byte(`{"status_code":"400","status_message":"Transaction details gross_amount does not match item details"}`))
+			return
+		}
+		w.Write([]byte(`{"status_code":"201","payment_type":"bank_transfer","va_numbers":[{"bank":"bni","va_number":"9888800012345678"}]}`))
+	}))
+	defer midtrans.Close()
+	defer os.Unsetenv("MIDTRANS_CHECKOUT")
+
 	createContext := func(token *jwt.Token, endpoint string) echo.Context {
 		req := httptest.NewRequest("", "/", nil)
 		rec := httptest.NewRecorder()
@@ -229,111 +563,284 @@ func (s *orderUsecaseSuite) TestAcceptOrCompleteOrder() {
 			http.StatusUnauthorized,
 		},
 		{
-			"ok",
+			"bad request",
 			nil,
 			createContext(jwt.NewWithClaims(
 				jwt.SigningMethodHS256,
 				&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
-			), "/v1/orders/:id/complete"),
+			), "/v1/orders/:id/accept"),
 			func() {
 				s.orderRepository.EXPECT().Find(
 					gomock.Eq(&model.Order{}),
 					gomock.Eq("1"),
 				).SetArg(0, model.Order{
-					Model: gorm.Model{ID: 1},
+					Model:         gorm.Model{ID: 1},
+					PaymentMethod: "manual",
 					Services: []model.Service{
 						{
 							Model:  gorm.Model{ID: 1},
 							UserID: 1,
+							Cost:   1000,
 						},
 					},
 				})
 
-				s.orderRepository.EXPECT().Save(gomock.Any())
+				s.bankAccountRepository.EXPECT().FindByUserID(
+					gomock.Eq(&model.BankAccount{}),
+					gomock.Eq(uint(1)),
+				)
 			},
-			http.StatusOK,
+			http.StatusBadRequest,
 		},
-	}
-
-	for _, testCase := range testCases {
-		s.T().Run(testCase.Name, func(t *testing.T) {
-			testCase.ExpectedFunc()
-			if apiError := s.usecase.AcceptOrCompleteOrder(testCase.Context, &model.Order{}); apiError != nil {
-				code, _ := apiError.APIError()
-				s.Equal(testCase.ExpectedCode, code)
-			}
-		})
-	}
-}
-
-func (s *orderUsecaseSuite) TestCancelOrder() {
-	createContext := func(token *jwt.Token, id string) echo.Context {
-		req := httptest.NewRequest("", "/", nil)
-		rec := httptest.NewRecorder()
-		ctx := echo.New().NewContext(req, rec)
-		ctx.Set("user", token)
-		ctx.SetParamNames("id")
-		ctx.SetParamValues(id)
-		return ctx
-	}
-
-	testCases := []struct {
-		Name         string
-		Body         any
-		Context      echo.Context
-		ExpectedFunc func()
-		ExpectedCode int
-	}{
 		{
-			"not found",
+			"ok",
 			nil,
-			createContext(nil, "1"),
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			), "/v1/orders/:id/accept"),
 			func() {
 				s.orderRepository.EXPECT().Find(
 					gomock.Eq(&model.Order{}),
 					gomock.Eq("1"),
-				)
+				).SetArg(0, model.Order{
+					Model:         gorm.Model{ID: 1},
+					PaymentMethod: "manual",
+					Services: []model.Service{
+						{
+							Model:  gorm.Model{ID: 1},
+							UserID: 1,
+							Cost:   1000,
+						},
+					},
+				})
+
+				s.bankAccountRepository.EXPECT().FindByUserID(
+					gomock.Eq(&model.BankAccount{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.BankAccount{Model: gorm.Model{ID: 1}, Bank: "bca", VANumber: "1234567890"})
+
+				s.paymentRepository.EXPECT().Create(gomock.Any()).Do(func(payment *model.Payment) {
+					s.Equal("manual", payment.Method)
+					s.Equal("1234567890", payment.VANumber)
+				})
+
+				s.orderRepository.EXPECT().Save(gomock.Any())
 			},
-			http.StatusNotFound,
+			http.StatusOK,
 		},
 		{
-			"unauthorized",
+			"bad gateway",
 			nil,
 			createContext(jwt.NewWithClaims(
 				jwt.SigningMethodHS256,
-				&helper.JWTCustomClaims{ID: 1},
-			), "1"),
+				&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			), "/v1/orders/:id/accept"),
 			func() {
+				os.Setenv("MIDTRANS_BASE_URL", midtrans.URL+"/error")
+
 				s.orderRepository.EXPECT().Find(
 					gomock.Eq(&model.Order{}),
 					gomock.Eq("1"),
-				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}, UserID: 2})
-			},
-			http.StatusUnauthorized,
-		},
+				).SetArg(0, model.Order{
+					Model:         gorm.Model{ID: 1},
+					PaymentMethod: "bank_transfer",
+					Bank:          "bni",
+					Services: []model.Service{
+						{
+							Model:  gorm.Model{ID: 1},
+							UserID: 1,
+							Cost:   1000,
+						},
+					},
+				})
+			},
+			http.StatusBadGateway,
+		},
 		{
 			"ok",
 			nil,
 			createContext(jwt.NewWithClaims(
 				jwt.SigningMethodHS256,
-				&helper.JWTCustomClaims{ID: 1},
-			), "1"),
+				&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			), "/v1/orders/:id/accept"),
 			func() {
+				os.Setenv("MIDTRANS_BASE_URL", midtrans.URL)
+
 				s.orderRepository.EXPECT().Find(
 					gomock.Eq(&model.Order{}),
 					gomock.Eq("1"),
-				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}, UserID: 1})
+				).SetArg(0, model.Order{
+					Model:         gorm.Model{ID: 1},
+					PaymentMethod: "bank_transfer",
+					Bank:          "bni",
+					Services: []model.Service{
+						{
+							Model:  gorm.Model{ID: 1},
+							UserID: 1,
+							Cost:   1000,
+						},
+					},
+					Items: []model.OrderItem{
+						{ServiceID: 1, Kind: model.OrderItemKindService, Name: "Service", Price: 1000, Quantity: 1},
+						{ServiceID: 1, AddonID: &addonID, Kind: model.OrderItemKindAddon, Name: "Service - Extra hour", Price: 250, Quantity: 2},
+					},
+				})
 
-				s.orderRepository.EXPECT().Delete(gomock.Any())
+				s.paymentRepository.EXPECT().Create(gomock.Any()).Do(func(payment *model.Payment) {
+					s.Equal("bni", payment.Bank)
+					s.Equal("9888800012345678", payment.VANumber)
+					s.Equal(model.Money(1500), payment.Amount)
+				})
+
+				s.orderRepository.EXPECT().Save(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"ok",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			), "/v1/orders/:id/accept"),
+			func() {
+				os.Setenv("MIDTRANS_CHECKOUT", "snap")
+				os.Setenv("MIDTRANS_SNAP_BASE_URL", midtrans.URL)
+
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{
+					Model:         gorm.Model{ID: 1},
+					PaymentMethod: "bank_transfer",
+					Bank:          "bni",
+					Services: []model.Service{
+						{
+							Model:  gorm.Model{ID: 1},
+							UserID: 1,
+							Cost:   1000,
+						},
+					},
+				})
+
+				s.paymentRepository.EXPECT().Create(gomock.Any()).Do(func(payment *model.Payment) {
+					s.Equal("snap", payment.Method)
+					s.Equal("66e4fa55-fdac-4ef9-91b5-733b97d1b862", payment.SnapToken)
+				})
+
+				s.orderRepository.EXPECT().Save(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"ok",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			), "/v1/orders/:id/complete"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{
+					Model:      gorm.Model{ID: 1},
+					IsAccepted: true,
+					Services: []model.Service{
+						{
+							Model:  gorm.Model{ID: 1},
+							UserID: 1,
+						},
+					},
+				})
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Status: "success"})
+
+				s.ledgerRepository.EXPECT().HasTransaction(gomock.Eq(model.LedgerKindRelease), gomock.Eq(uint(1)))
+				s.ledgerRepository.EXPECT().Post(gomock.Any())
+
+				s.orderRepository.EXPECT().Save(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"already released",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			), "/v1/orders/:id/complete"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{
+					Model:      gorm.Model{ID: 1},
+					IsAccepted: true,
+					Services: []model.Service{
+						{
+							Model:  gorm.Model{ID: 1},
+							UserID: 1,
+						},
+					},
+				})
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Status: "success"})
+
+				s.ledgerRepository.EXPECT().HasTransaction(gomock.Eq(model.LedgerKindRelease), gomock.Eq(uint(1))).Return(true, nil)
+
+				s.orderRepository.EXPECT().Save(gomock.Any())
 			},
 			http.StatusOK,
 		},
+		{
+			"post failure",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			), "/v1/orders/:id/complete"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{
+					Model:      gorm.Model{ID: 1},
+					IsAccepted: true,
+					Services: []model.Service{
+						{
+							Model:  gorm.Model{ID: 1},
+							UserID: 1,
+						},
+					},
+				})
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Status: "success"})
+
+				s.ledgerRepository.EXPECT().HasTransaction(gomock.Eq(model.LedgerKindRelease), gomock.Eq(uint(1)))
+				s.ledgerRepository.EXPECT().Post(gomock.Any()).Return(errors.New("db error"))
+			},
+			http.StatusInternalServerError,
+		},
 	}
 
 	for _, testCase := range testCases {
 		s.T().Run(testCase.Name, func(t *testing.T) {
 			testCase.ExpectedFunc()
-			if apiError := s.usecase.CancelOrder(testCase.Context, &model.Order{}); apiError != nil {
+			if apiError := s.usecase.AcceptOrCompleteOrder(testCase.Context, &model.Order{}, &model.Payment{}); apiError != nil {
 				code, _ := apiError.APIError()
 				s.Equal(testCase.ExpectedCode, code)
 			}
@@ -341,63 +848,114 @@ func (s *orderUsecaseSuite) TestCancelOrder() {
 	}
 }
 
-func (s *orderUsecaseSuite) TestPaymentStatus() {
+func (s *orderUsecaseSuite) TestUploadReceipt() {
+	createContext := func(token *jwt.Token, receipt []byte) echo.Context {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		if receipt != nil {
+			part, _ := writer.CreateFormFile("receipt", "receipt")
+			part.Write(receipt)
+		}
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.Set("user", token)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues("1")
+		return ctx
+	}
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	customer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "customer"})
+	order := model.Order{Model: gorm.Model{ID: 1}, UserID: 2}
+
 	testCases := []struct {
 		Name         string
-		Body         *request.MidtransTransactionNotificationRequest
+		Context      echo.Context
 		ExpectedFunc func()
 		ExpectedCode int
 	}{
 		{
 			"not found",
-			&request.MidtransTransactionNotificationRequest{
-				OrderID: "EOP-1",
-				Status:  "",
-			},
+			createContext(customer, png),
 			func() {
-				s.paymentRepository.EXPECT().FindOnlyByOrderID(
-					gomock.Eq(&model.Payment{}),
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
 					gomock.Eq("1"),
 				)
 			},
 			http.StatusNotFound,
 		},
 		{
-			"ok",
-			&request.MidtransTransactionNotificationRequest{
-				OrderID: "EOP-1",
-				Status:  "settlement",
+			"unauthorized",
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 3, Role: "customer"},
+			), png),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, order)
 			},
+			http.StatusUnauthorized,
+		},
+		{
+			"bad request",
+			createContext(customer, png),
 			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, order)
+
 				s.paymentRepository.EXPECT().FindOnlyByOrderID(
 					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, Method: "bank_transfer", Status: "pending"})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"bad request",
+			createContext(customer, []byte("not an image")),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
 					gomock.Eq("1"),
-				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1})
+				).SetArg(0, order)
 
-				s.paymentRepository.EXPECT().Update(gomock.Any(), gomock.Any())
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, Method: "manual", Status: "pending"})
 			},
-			http.StatusOK,
+			http.StatusBadRequest,
 		},
 		{
 			"ok",
-			&request.MidtransTransactionNotificationRequest{
-				OrderID: "EOP-1",
-				Status:  "deny",
-			},
+			createContext(customer, png),
 			func() {
-				s.paymentRepository.EXPECT().FindOnlyByOrderID(
-					gomock.Eq(&model.Payment{}),
-					gomock.Eq("1"),
-				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1})
-
-				s.orderRepository.EXPECT().FindOnly(
+				s.orderRepository.EXPECT().Find(
 					gomock.Eq(&model.Order{}),
 					gomock.Eq("1"),
-				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}})
+				).SetArg(0, order)
 
-				s.orderRepository.EXPECT().Delete(gomock.Any())
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, Method: "manual", Status: "rejected", ReceiptKey: "receipts/old.png"})
 
-				s.paymentRepository.EXPECT().Update(gomock.Any(), gomock.Any())
+				s.storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq("image/png")).Return("/receipts/1.png", nil)
+				s.storage.EXPECT().Delete(gomock.Eq("receipts/old.png"))
+
+				s.paymentRepository.EXPECT().Save(gomock.Any()).Do(func(payment *model.Payment) {
+					s.Equal("review", payment.Status)
+					s.Equal("/v1/orders/1/receipt", payment.ReceiptURL)
+				})
 			},
 			http.StatusOK,
 		},
@@ -406,10 +964,991 @@ func (s *orderUsecaseSuite) TestPaymentStatus() {
 	for _, testCase := range testCases {
 		s.T().Run(testCase.Name, func(t *testing.T) {
 			testCase.ExpectedFunc()
-			if apiError := s.usecase.PaymentStatus(testCase.Body); apiError != nil {
-				code, _ := apiError.APIError()
-				s.Equal(testCase.ExpectedCode, code)
+			code := http.StatusOK
+			if apiError := s.usecase.UploadReceipt(testCase.Context, &model.Order{}, &model.Payment{}); apiError != nil {
+				code, _ = apiError.APIError()
 			}
+			s.Equal(testCase.ExpectedCode, code)
 		})
 	}
 }
+
+func (s *orderUsecaseSuite) TestGetReceipt() {
+	createContext := func(token *jwt.Token) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.Set("user", token)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues("1")
+		return ctx
+	}
+
+	customer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 2, Role: "customer"})
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+	order := model.Order{
+		Model:    gorm.Model{ID: 1},
+		UserID:   2,
+		Services: []model.Service{{Model: gorm.Model{ID: 1}, UserID: 1}},
+	}
+	payment := model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Method: "manual", ReceiptKey: "receipts/1-1.png"}
+
+	testCases := []struct {
+		Name         string
+		Context      echo.Context
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			createContext(customer),
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"unauthorized",
+			createContext(jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 3, Role: "customer"})),
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, order)
+			},
+			http.StatusUnauthorized,
+		},
+		{
+			"no receipt",
+			createContext(customer),
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, order)
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(gomock.Any(), gomock.Eq(uint(1))).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, Method: "manual"})
+			},
+			http.StatusNotFound,
+		},
+		{
+			"missing file",
+			createContext(customer),
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, order)
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(gomock.Any(), gomock.Eq(uint(1))).SetArg(0, payment)
+				s.storage.EXPECT().Get(gomock.Eq("receipts/1-1.png")).Return(nil, fs.ErrNotExist)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"customer",
+			createContext(customer),
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, order)
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(gomock.Any(), gomock.Eq(uint(1))).SetArg(0, payment)
+				s.storage.EXPECT().Get(gomock.Eq("receipts/1-1.png")).Return(io.NopCloser(strings.NewReader("receipt")), nil)
+			},
+			http.StatusOK,
+		},
+		{
+			"organizer",
+			createContext(organizer),
+			func() {
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, order)
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(gomock.Any(), gomock.Eq(uint(1))).SetArg(0, payment)
+				s.storage.EXPECT().Get(gomock.Eq("receipts/1-1.png")).Return(io.NopCloser(strings.NewReader("receipt")), nil)
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			receipt, apiError := s.usecase.GetReceipt(testCase.Context, &model.Payment{})
+			if apiError != nil {
+				code, _ = apiError.APIError()
+			} else {
+				receipt.Close()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *orderUsecaseSuite) TestConfirmOrRejectReceipt() {
+	createContext := func(token *jwt.Token, endpoint string) echo.Context {
+		req := httptest.NewRequest("", "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetPath(endpoint)
+		ctx.Set("user", token)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues("1")
+		return ctx
+	}
+
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+	order := model.Order{
+		Model:    gorm.Model{ID: 1},
+		Services: []model.Service{{Model: gorm.Model{ID: 1}, UserID: 1, Cost: 1000}},
+	}
+
+	testCases := []struct {
+		Name         string
+		Body         *request.ReviewReceiptRequest
+		Context      echo.Context
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			&request.ReviewReceiptRequest{},
+			createContext(organizer, "/v1/orders/:id/receipt/confirm"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"unauthorized",
+			&request.ReviewReceiptRequest{},
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 2, Role: "organizer"},
+			), "/v1/orders/:id/receipt/confirm"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, order)
+			},
+			http.StatusUnauthorized,
+		},
+		{
+			"bad request",
+			&request.ReviewReceiptRequest{},
+			createContext(organizer, "/v1/orders/:id/receipt/confirm"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, order)
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, Method: "manual", Status: "pending"})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			&request.ReviewReceiptRequest{},
+			createContext(organizer, "/v1/orders/:id/receipt/confirm"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, order)
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, Amount: 1000, Method: "manual", Status: "review"})
+
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, order)
+
+				s.commissionRateRepository.EXPECT().FindByScope(gomock.Any(), gomock.Eq(model.CommissionScopeOrganizer), gomock.Eq(uint(1)))
+
+				s.ledgerRepository.EXPECT().Post(gomock.Any()).Do(func(transaction *model.LedgerTransaction) {
+					s.Equal(model.LedgerKindPayment, transaction.Kind)
+				})
+
+				s.paymentRepository.EXPECT().Save(gomock.Any()).Do(func(payment *model.Payment) {
+					s.Equal("success", payment.Status)
+					s.Equal(model.Money(100), payment.Commission)
+					s.Equal(model.Money(900), payment.Earning)
+				})
+			},
+			http.StatusOK,
+		},
+		{
+			"ok",
+			&request.ReviewReceiptRequest{},
+			createContext(organizer, "/v1/orders/:id/receipt/confirm"),
+			func() {
+				completed := order
+				completed.IsAccepted = true
+				completed.IsCompleted = true
+
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, completed)
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, Amount: 1000, Method: "manual", Status: "review"})
+
+				s.orderRepository.EXPECT().Find(gomock.Any(), gomock.Eq("1")).SetArg(0, completed)
+
+				s.commissionRateRepository.EXPECT().FindByScope(gomock.Any(), gomock.Eq(model.CommissionScopeOrganizer), gomock.Eq(uint(1)))
+
+				s.ledgerRepository.EXPECT().Post(gomock.Any())
+				s.ledgerRepository.EXPECT().HasTransaction(gomock.Eq(model.LedgerKindRelease), gomock.Eq(uint(1)))
+				s.ledgerRepository.EXPECT().Post(gomock.Any()).Do(func(transaction *model.LedgerTransaction) {
+					s.Equal(model.LedgerKindRelease, transaction.Kind)
+
+					balance := model.Money(0)
+					for _, entry := range transaction.Entries {
+						if entry.Account == model.LedgerAccountOrganizer {
+							balance += entry.Amount
+						}
+					}
+					s.Equal(model.Money(-100), balance)
+				})
+
+				s.paymentRepository.EXPECT().Save(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"ok",
+			&request.ReviewReceiptRequest{Note: "amount does not match"},
+			createContext(organizer, "/v1/orders/:id/receipt/reject"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, order)
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, Amount: 1000, Method: "manual", Status: "review"})
+
+				s.paymentRepository.EXPECT().Save(gomock.Any()).Do(func(payment *model.Payment) {
+					s.Equal("rejected", payment.Status)
+					s.Equal("amount does not match", payment.ReceiptNote)
+				})
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			if apiError := s.usecase.ConfirmOrRejectReceipt(testCase.Context, &model.Order{}, &model.Payment{}, testCase.Body); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *orderUsecaseSuite) TestCancelOrder() {
+	createContext := func(token *jwt.Token, id string) echo.Context {
+		req := httptest.NewRequest("", "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.Set("user", token)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues(id)
+		return ctx
+	}
+
+	testCases := []struct {
+		Name         string
+		Body         any
+		Context      echo.Context
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			nil,
+			createContext(nil, "1"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"unauthorized",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1},
+			), "1"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}, UserID: 2})
+			},
+			http.StatusUnauthorized,
+		},
+		{
+			"ok",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1},
+			), "1"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}, UserID: 1})
+
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				)
+
+				s.orderRepository.EXPECT().DeleteUnaccepted(gomock.Any()).Return(true, nil)
+			},
+			http.StatusOK,
+		},
+		{
+			"accepted",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1},
+			), "1"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}, UserID: 1, IsAccepted: true})
+
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"paid",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1},
+			), "1"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}, UserID: 1})
+
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Status: "success"})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"accepted concurrently",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1},
+			), "1"),
+			func() {
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}, UserID: 1})
+
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq(uint(1)),
+				)
+
+				s.orderRepository.EXPECT().DeleteUnaccepted(gomock.Any()).Return(false, nil)
+			},
+			http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			if apiError := s.usecase.CancelOrder(testCase.Context, &model.Order{}); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *orderUsecaseSuite) TestPaymentStatus() {
+	categoryID := uint(2)
+
+	// paid looks up the payment a notification's amount is checked against.
+	paid := func() {
+		s.paymentRepository.EXPECT().FindOnlyByOrderID(
+			gomock.Eq(&model.Payment{}),
+			gomock.Eq("1"),
+		).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Amount: 1000, Status: "pending"})
+	}
+
+	testCases := []struct {
+		Name         string
+		Body         *request.MidtransTransactionNotificationRequest
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"already processed",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).Return(false, nil)
+
+				s.webhookNotificationRepository.EXPECT().FindByKey(
+					gomock.Eq(&model.WebhookNotification{}),
+					gomock.Eq("abc"),
+					gomock.Eq("settlement"),
+				).SetArg(0, model.WebhookNotification{Model: gorm.Model{ID: 1}, Status: model.WebhookStatusProcessed})
+			},
+			http.StatusOK,
+		},
+		{
+			"being processed",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).Return(false, nil)
+
+				s.webhookNotificationRepository.EXPECT().FindByKey(
+					gomock.Eq(&model.WebhookNotification{}),
+					gomock.Eq("abc"),
+					gomock.Eq("settlement"),
+				).SetArg(0, model.WebhookNotification{Model: gorm.Model{ID: 1}, Status: model.WebhookStatusReceived})
+			},
+			http.StatusOK,
+		},
+		{
+			"failed and claimed by another delivery",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).Return(false, nil)
+
+				s.webhookNotificationRepository.EXPECT().FindByKey(
+					gomock.Eq(&model.WebhookNotification{}),
+					gomock.Eq("abc"),
+					gomock.Eq("settlement"),
+				).SetArg(0, model.WebhookNotification{Model: gorm.Model{ID: 1}, Status: model.WebhookStatusFailed})
+
+				s.webhookNotificationRepository.EXPECT().Claim(gomock.Any()).Return(false)
+			},
+			http.StatusOK,
+		},
+		{
+			"internal server error",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).Return(false, errors.New("connection lost"))
+			},
+			http.StatusInternalServerError,
+		},
+		{
+			"forged signature",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+				SignatureKey:  "forged",
+			},
+			func() {},
+			http.StatusUnauthorized,
+		},
+		{
+			"not found",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+			},
+			func() {
+				s.paymentRepository.EXPECT().FindOnlyByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"wrong amount",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+				GrossAmount:   "1.00",
+			},
+			func() {
+				paid()
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).DoAndReturn(func(notification *model.WebhookNotification) (bool, error) {
+					s.Equal("abc", notification.TransactionID)
+					s.Equal("settlement", notification.TransactionStatus)
+					return true, nil
+				})
+
+				s.webhookNotificationRepository.EXPECT().Save(gomock.Any()).Do(func(notification *model.WebhookNotification) {
+					s.Equal(model.WebhookStatusProcessed, notification.Status)
+				})
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Amount: 1000, Status: "pending"})
+
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{
+					Model: gorm.Model{ID: 1},
+					Services: []model.Service{
+						{
+							Model:  gorm.Model{ID: 1},
+							UserID: 1,
+							Cost:   1000,
+						},
+					},
+				})
+
+				s.commissionRateRepository.EXPECT().FindByScope(
+					gomock.Eq(&model.CommissionRate{}),
+					gomock.Eq(model.CommissionScopeOrganizer),
+					gomock.Eq(uint(1)),
+				)
+
+				s.ledgerRepository.EXPECT().Post(gomock.Any())
+
+				s.paymentRepository.EXPECT().Update(gomock.Any(), gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"category rate",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "def",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).DoAndReturn(func(notification *model.WebhookNotification) (bool, error) {
+					s.Equal("def", notification.TransactionID)
+					s.Equal("settlement", notification.TransactionStatus)
+					return true, nil
+				})
+
+				s.webhookNotificationRepository.EXPECT().Save(gomock.Any())
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Amount: 1000, Status: "pending"})
+
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{
+					Model: gorm.Model{ID: 1},
+					Services: []model.Service{
+						{
+							Model:      gorm.Model{ID: 1},
+							UserID:     1,
+							CategoryID: &categoryID,
+							Cost:       1000,
+						},
+					},
+				})
+
+				s.commissionRateRepository.EXPECT().FindByScope(
+					gomock.Eq(&model.CommissionRate{}),
+					gomock.Eq(model.CommissionScopeOrganizer),
+					gomock.Eq(uint(1)),
+				)
+
+				s.commissionRateRepository.EXPECT().FindByScope(
+					gomock.Eq(&model.CommissionRate{}),
+					gomock.Eq(model.CommissionScopeCategory),
+					gomock.Eq(uint(2)),
+				).SetArg(0, model.CommissionRate{Model: gorm.Model{ID: 1}, Rate: 0.25})
+
+				s.ledgerRepository.EXPECT().Post(gomock.Any())
+
+				s.paymentRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Do(func(payment *model.Payment, req *request.MidtransTransactionNotificationRequest) {
+					s.Equal(model.Money(250), payment.Commission)
+					s.Equal(model.Money(750), payment.Earning)
+				})
+			},
+			http.StatusOK,
+		},
+		{
+			"completed order is released",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "ghi",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).Return(true, nil)
+
+				s.webhookNotificationRepository.EXPECT().Save(gomock.Any()).Do(func(notification *model.WebhookNotification) {
+					s.Equal(model.WebhookStatusProcessed, notification.Status)
+				})
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Amount: 1000, Status: "pending"})
+
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{
+					Model:       gorm.Model{ID: 1},
+					IsAccepted:  true,
+					IsCompleted: true,
+					Services:    []model.Service{{Model: gorm.Model{ID: 1}, UserID: 1, Cost: 1000}},
+				})
+
+				s.commissionRateRepository.EXPECT().FindByScope(gomock.Any(), gomock.Eq(model.CommissionScopeOrganizer), gomock.Eq(uint(1)))
+
+				s.ledgerRepository.EXPECT().Post(gomock.Any()).Do(func(transaction *model.LedgerTransaction) {
+					s.Equal(model.LedgerKindPayment, transaction.Kind)
+				})
+				s.ledgerRepository.EXPECT().HasTransaction(gomock.Eq(model.LedgerKindRelease), gomock.Eq(uint(1)))
+				s.ledgerRepository.EXPECT().Post(gomock.Any()).Do(func(transaction *model.LedgerTransaction) {
+					s.Equal(model.LedgerKindRelease, transaction.Kind)
+				})
+
+				s.paymentRepository.EXPECT().Update(gomock.Any(), gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"ledger failure",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "jkl",
+				OrderID:       "EOP-1",
+				Status:        "settlement",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).Return(true, nil)
+
+				s.webhookNotificationRepository.EXPECT().Save(gomock.Any()).Do(func(notification *model.WebhookNotification) {
+					s.Equal(model.WebhookStatusFailed, notification.Status)
+				})
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Amount: 1000, Status: "pending"})
+
+				s.orderRepository.EXPECT().Find(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{
+					Model:    gorm.Model{ID: 1},
+					Services: []model.Service{{Model: gorm.Model{ID: 1}, UserID: 1, Cost: 1000}},
+				})
+
+				s.commissionRateRepository.EXPECT().FindByScope(gomock.Any(), gomock.Eq(model.CommissionScopeOrganizer), gomock.Eq(uint(1)))
+
+				s.ledgerRepository.EXPECT().Post(gomock.Any()).Return(errors.New("connection lost"))
+			},
+			http.StatusInternalServerError,
+		},
+		{
+			"late pending is ignored",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "pending",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).DoAndReturn(func(notification *model.WebhookNotification) (bool, error) {
+					s.Equal("abc", notification.TransactionID)
+					s.Equal("pending", notification.TransactionStatus)
+					return true, nil
+				})
+
+				s.webhookNotificationRepository.EXPECT().Save(gomock.Any()).Do(func(notification *model.WebhookNotification) {
+					s.Equal(model.WebhookStatusIgnored, notification.Status)
+				})
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Status: "success"})
+			},
+			http.StatusOK,
+		},
+		{
+			"retried settlement is ignored",
+			&request.MidtransTransactionNotificationRequest{
+				OrderID: "EOP-1",
+				Status:  "settlement",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).DoAndReturn(func(notification *model.WebhookNotification) (bool, error) {
+					s.Equal("EOP-1", notification.TransactionID)
+					s.Equal("settlement", notification.TransactionStatus)
+					return true, nil
+				})
+
+				s.webhookNotificationRepository.EXPECT().Save(gomock.Any())
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Status: "success"})
+			},
+			http.StatusOK,
+		},
+		{
+			"ok",
+			&request.MidtransTransactionNotificationRequest{
+				TransactionID: "abc",
+				OrderID:       "EOP-1",
+				Status:        "deny",
+			},
+			func() {
+				paid()
+
+				s.webhookNotificationRepository.EXPECT().Create(gomock.Any()).DoAndReturn(func(notification *model.WebhookNotification) (bool, error) {
+					s.Equal("abc", notification.TransactionID)
+					s.Equal("deny", notification.TransactionStatus)
+					return true, nil
+				})
+
+				s.webhookNotificationRepository.EXPECT().Save(gomock.Any())
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Status: "pending"})
+
+				s.orderRepository.EXPECT().FindOnly(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}})
+
+				s.orderRepository.EXPECT().Delete(gomock.Any())
+
+				s.paymentRepository.EXPECT().Update(gomock.Any(), gomock.Any())
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			// Notifications are signed as Midtrans would, for the amount of
+			// the payment, unless the case says otherwise.
+			body := *testCase.Body
+			body.StatusCode = "200"
+			if body.GrossAmount == "" {
+				body.GrossAmount = "1000.00"
+			}
+			if body.SignatureKey == "" {
+				body.SignatureKey = helper.MidtransSignature(body.OrderID, body.StatusCode, body.GrossAmount, config.LoadMidtransConfig().ServerKey)
+			}
+
+			code := http.StatusOK
+			if apiError := s.usecase.PaymentStatus(&body); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *orderUsecaseSuite) TestGetWebhookNotifications() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	s.webhookNotificationRepository.EXPECT().Get(
+		gomock.Eq(&[]model.WebhookNotification{}),
+		gomock.Eq(model.WebhookStatusFailed),
+	)
+
+	s.Nil(s.usecase.GetWebhookNotifications(admin, &[]model.WebhookNotification{}, model.WebhookStatusFailed))
+}
+
+func (s *orderUsecaseSuite) TestReplayWebhookNotification() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	createContext := func(id string) echo.Context {
+		req := httptest.NewRequest("", "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues(id)
+		return ctx
+	}
+
+	testCases := []struct {
+		Name         string
+		Context      echo.Context
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			createContext("1"),
+			func() {
+				s.webhookNotificationRepository.EXPECT().Find(
+					gomock.Eq(&model.WebhookNotification{}),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"bad request",
+			createContext("1"),
+			func() {
+				s.webhookNotificationRepository.EXPECT().Find(
+					gomock.Eq(&model.WebhookNotification{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.WebhookNotification{Model: gorm.Model{ID: 1}, Status: model.WebhookStatusProcessed})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			createContext("1"),
+			func() {
+				s.webhookNotificationRepository.EXPECT().Find(
+					gomock.Eq(&model.WebhookNotification{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.WebhookNotification{
+					Model:             gorm.Model{ID: 1},
+					TransactionID:     "abc",
+					TransactionStatus: "expire",
+					OrderID:           "EOP-1",
+					Status:            model.WebhookStatusFailed,
+					Attempts:          1,
+				})
+
+				s.webhookNotificationRepository.EXPECT().Claim(gomock.Any()).Return(true)
+
+				s.expectTransaction()
+				s.paymentRepository.EXPECT().LockByOrderID(
+					gomock.Eq(&model.Payment{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payment{Model: gorm.Model{ID: 1}, OrderID: 1, Status: "pending"})
+
+				s.orderRepository.EXPECT().FindOnly(
+					gomock.Eq(&model.Order{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Order{Model: gorm.Model{ID: 1}})
+
+				s.orderRepository.EXPECT().Delete(gomock.Any())
+
+				s.paymentRepository.EXPECT().Update(gomock.Any(), gomock.Any())
+
+				s.webhookNotificationRepository.EXPECT().Save(gomock.Any()).Do(func(notification *model.WebhookNotification) {
+					s.Equal(model.WebhookStatusProcessed, notification.Status)
+					s.Equal(2, notification.Attempts)
+				})
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			if apiError := s.usecase.ReplayWebhookNotification(admin, testCase.Context, &model.WebhookNotification{}); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *orderUsecaseSuite) TestWebhookNotificationUnauthorized() {
+	claims := &helper.JWTCustomClaims{ID: 2, Role: "organizer"}
+
+	for _, apiError := range []helper.APIError{
+		s.usecase.GetWebhookNotifications(claims, &[]model.WebhookNotification{}, ""),
+		s.usecase.ReplayWebhookNotification(claims, nil, &model.WebhookNotification{}),
+	} {
+		s.NotNil(apiError)
+		code, _ := apiError.APIError()
+		s.Equal(http.StatusUnauthorized, code)
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+)
+
+type OrganizerUsecase interface {
+	FindOrganizer(organizer *model.Organizer, id string) helper.APIError
+}
+
+type organizerUsecase struct {
+	userRepository             r.UserRepository
+	organizerProfileRepository r.OrganizerProfileRepository
+	serviceRepository          r.ServiceRepository
+	ratingAggregateRepository  r.RatingAggregateRepository
+	orderRepository            r.OrderRepository
+}
+
+func NewOrganizerUsecase(
+	userRepository r.UserRepository,
+	organizerProfileRepository r.OrganizerProfileRepository,
+	serviceRepository r.ServiceRepository,
+	ratingAggregateRepository r.RatingAggregateRepository,
+	orderRepository r.OrderRepository,
+) OrganizerUsecase {
+	return &organizerUsecase{
+		userRepository,
+		organizerProfileRepository,
+		serviceRepository,
+		ratingAggregateRepository,
+		orderRepository,
+	}
+}
+
+func (u *organizerUsecase) FindOrganizer(organizer *model.Organizer, id string) helper.APIError {
+	userID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return helper.NewAPIError(http.StatusNotFound, "organizer not found")
+	}
+
+	u.userRepository.Find(&organizer.User, uint(userID))
+
+	if organizer.User.ID == 0 || organizer.User.Role != "organizer" {
+		return helper.NewAPIError(http.StatusNotFound, "organizer not found")
+	}
+
+	u.organizerProfileRepository.FindByUserID(&organizer.Profile, organizer.User.ID)
+	u.serviceRepository.Get(&organizer.Services, &model.ServiceFilter{
+		Status:      model.ServiceStatusPublished,
+		OrganizerID: organizer.User.ID,
+		Sort:        model.ServiceSortNewest,
+	})
+	u.ratingAggregateRepository.Find(&organizer.Rating, model.RatedTypeOrganizer, organizer.User.ID)
+	organizer.CompletedEvents = u.orderRepository.GetCompletedCountForOrganizer(organizer.User.ID)
+
+	return nil
+}
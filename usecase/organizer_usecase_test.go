@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/model"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type organizerUsecaseSuite struct {
+	suite.Suite
+
+	ctrl                       *gomock.Controller
+	userRepository             *mr.MockUserRepository
+	organizerProfileRepository *mr.MockOrganizerProfileRepository
+	serviceRepository          *mr.MockServiceRepository
+	ratingAggregateRepository  *mr.MockRatingAggregateRepository
+	orderRepository            *mr.MockOrderRepository
+
+	usecase OrganizerUsecase
+}
+
+func (s *organizerUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.userRepository = mr.NewMockUserRepository(s.ctrl)
+	s.organizerProfileRepository = mr.NewMockOrganizerProfileRepository(s.ctrl)
+	s.serviceRepository = mr.NewMockServiceRepository(s.ctrl)
+	s.ratingAggregateRepository = mr.NewMockRatingAggregateRepository(s.ctrl)
+	s.orderRepository = mr.NewMockOrderRepository(s.ctrl)
+
+	s.usecase = NewOrganizerUsecase(
+		s.userRepository,
+		s.organizerProfileRepository,
+		s.serviceRepository,
+		s.ratingAggregateRepository,
+		s.orderRepository,
+	)
+}
+
+func (s *organizerUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestOrganizerUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(organizerUsecaseSuite))
+}
+
+func (s *organizerUsecaseSuite) TestFindOrganizer() {
+	testCases := []struct {
+		Name         string
+		ID           string
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"invalid id",
+			"abc",
+			func() {},
+			http.StatusNotFound,
+		},
+		{
+			"not found",
+			"1",
+			func() {
+				s.userRepository.EXPECT().Find(
+					gomock.Eq(&model.User{}),
+					gomock.Eq(uint(1)),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"not an organizer",
+			"1",
+			func() {
+				s.userRepository.EXPECT().Find(
+					gomock.Eq(&model.User{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.User{Model: gorm.Model{ID: 1}, Role: "customer"})
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			"1",
+			func() {
+				s.userRepository.EXPECT().Find(
+					gomock.Eq(&model.User{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.User{Model: gorm.Model{ID: 1}, Role: "organizer"})
+
+				s.organizerProfileRepository.EXPECT().FindByUserID(
+					gomock.Eq(&model.OrganizerProfile{}),
+					gomock.Eq(uint(1)),
+				)
+
+				s.serviceRepository.EXPECT().Get(
+					gomock.Any(),
+					gomock.Eq(&model.ServiceFilter{
+						Status:      model.ServiceStatusPublished,
+						OrganizerID: 1,
+						Sort:        model.ServiceSortNewest,
+					}),
+				)
+
+				s.ratingAggregateRepository.EXPECT().Find(
+					gomock.Eq(&model.RatingAggregate{}),
+					gomock.Eq(model.RatedTypeOrganizer),
+					gomock.Eq(uint(1)),
+				)
+
+				s.orderRepository.EXPECT().GetCompletedCountForOrganizer(gomock.Eq(uint(1))).Return(int64(4))
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			code := http.StatusOK
+			if apiError := s.usecase.FindOrganizer(&model.Organizer{}, testCase.ID); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
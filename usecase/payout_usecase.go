@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/labstack/echo/v4"
+)
+
+type PayoutUsecase interface {
+	GetPayouts(claims *helper.JWTCustomClaims, payouts *[]model.Payout, status string)
+	CreatePayout(claims *helper.JWTCustomClaims, payout *model.Payout, req *request.CreatePayoutRequest) helper.APIError
+	CompleteOrRejectPayout(claims *helper.JWTCustomClaims, ctx echo.Context, payout *model.Payout) helper.APIError
+}
+
+type payoutUsecase struct {
+	transactor            r.Transactor
+	payoutRepository      r.PayoutRepository
+	ledgerRepository      r.LedgerRepository
+	bankAccountRepository r.BankAccountRepository
+}
+
+func NewPayoutUsecase(
+	transactor r.Transactor,
+	payoutRepository r.PayoutRepository,
+	ledgerRepository r.LedgerRepository,
+	bankAccountRepository r.BankAccountRepository,
+) PayoutUsecase {
+	return &payoutUsecase{
+		transactor,
+		payoutRepository,
+		ledgerRepository,
+		bankAccountRepository,
+	}
+}
+
+func (u *payoutUsecase) GetPayouts(claims *helper.JWTCustomClaims, payouts *[]model.Payout, status string) {
+	if claims.Role == "admin" {
+		u.payoutRepository.Get(payouts, status)
+	}
+	if claims.Role == "organizer" {
+		u.payoutRepository.GetForUser(payouts, claims.ID)
+	}
+}
+
+func (u *payoutUsecase) CreatePayout(claims *helper.JWTCustomClaims, payout *model.Payout, req *request.CreatePayoutRequest) helper.APIError {
+	bankAccount := model.BankAccount{}
+	u.bankAccountRepository.FindByUserID(&bankAccount, claims.ID)
+
+	if bankAccount.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "bank account not found")
+	}
+
+	// The balance check, the payout and its ledger entries are written in
+	// one transaction holding the organizer's account lock, so concurrent
+	// requests can't overdraw the balance.
+	err := u.transactor.Transaction(func(tx r.Tx) error {
+		ledgerRepository := u.ledgerRepository.WithTx(tx)
+		payoutRepository := u.payoutRepository.WithTx(tx)
+
+		if err := ledgerRepository.LockAccount(claims.ID); err != nil {
+			return err
+		}
+
+		available := ledgerRepository.GetBalance(model.LedgerAccountOrganizer, claims.ID)
+		if req.Amount > available {
+			return helper.NewAPIError(http.StatusBadRequest, "insufficient balance")
+		}
+
+		payout.Amount = req.Amount
+		payout.Status = "pending"
+		payout.UserID = claims.ID
+		payout.BankAccountID = bankAccount.ID
+		if err := payoutRepository.Create(payout); err != nil {
+			return err
+		}
+
+		transaction := newLedgerTransfer(
+			model.LedgerKindPayout,
+			claims.ID,
+			payout.Amount,
+			model.LedgerAccountOrganizer,
+			model.LedgerAccountPayout,
+		)
+		transaction.PayoutID = &payout.ID
+
+		return ledgerRepository.Post(transaction)
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+
+	payout.BankAccount = bankAccount
+
+	return nil
+}
+
+func (u *payoutUsecase) CompleteOrRejectPayout(claims *helper.JWTCustomClaims, ctx echo.Context, payout *model.Payout) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.payoutRepository.Find(payout, ctx.Param("id"))
+
+	if payout.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "payout not found")
+	}
+
+	if payout.Status != "pending" {
+		return helper.NewAPIError(http.StatusBadRequest, "payout already processed")
+	}
+
+	var transaction *model.LedgerTransaction
+
+	segment := strings.Split(ctx.Path(), "/")[4]
+	if segment == "complete" {
+		payout.Status = "paid"
+		transaction = newLedgerTransfer(
+			model.LedgerKindPayoutPaid,
+			payout.UserID,
+			payout.Amount,
+			model.LedgerAccountPayout,
+			model.LedgerAccountDisbursed,
+		)
+	}
+	if segment == "reject" {
+		payout.Status = "rejected"
+		transaction = newLedgerTransfer(
+			model.LedgerKindPayoutReverse,
+			payout.UserID,
+			payout.Amount,
+			model.LedgerAccountPayout,
+			model.LedgerAccountOrganizer,
+		)
+	}
+
+	if transaction == nil {
+		return helper.NewAPIError(http.StatusNotFound, "not found")
+	}
+
+	// The payout only moves on from pending once, so that two concurrent
+	// calls can't both post its transfer.
+	err := u.transactor.Transaction(func(tx r.Tx) error {
+		updated, err := u.payoutRepository.WithTx(tx).UpdateStatus(payout, "pending")
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return helper.NewAPIError(http.StatusBadRequest, "payout already processed")
+		}
+
+		transaction.PayoutID = &payout.ID
+
+		return u.ledgerRepository.WithTx(tx).Post(transaction)
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+
+	return nil
+}
+
+// transactionError returns the API error a transaction was rolled back
+// with, or logs any other error and reports it as an internal one.
+func transactionError(err error) helper.APIError {
+	if apiError, ok := err.(helper.APIError); ok {
+		return apiError
+	}
+
+	log.Printf("Error: %s", err)
+	return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+}
@@ -0,0 +1,350 @@
+package usecase
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type payoutUsecaseSuite struct {
+	suite.Suite
+
+	ctrl                  *gomock.Controller
+	transactor            *mr.MockTransactor
+	payoutRepository      *mr.MockPayoutRepository
+	ledgerRepository      *mr.MockLedgerRepository
+	bankAccountRepository *mr.MockBankAccountRepository
+
+	usecase PayoutUsecase
+}
+
+func (s *payoutUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.transactor = mr.NewMockTransactor(s.ctrl)
+	s.payoutRepository = mr.NewMockPayoutRepository(s.ctrl)
+	s.ledgerRepository = mr.NewMockLedgerRepository(s.ctrl)
+	s.bankAccountRepository = mr.NewMockBankAccountRepository(s.ctrl)
+
+	s.usecase = NewPayoutUsecase(
+		s.transactor,
+		s.payoutRepository,
+		s.ledgerRepository,
+		s.bankAccountRepository,
+	)
+}
+
+func (s *payoutUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestPayoutUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(payoutUsecaseSuite))
+}
+
+// expectTransaction runs the usecase's transaction with the suite's mocks
+// bound to it, after the organizer's account is locked.
+func (s *payoutUsecaseSuite) expectTransaction() {
+	s.transactor.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(tx r.Tx) error) error {
+		return fn(r.Tx{})
+	})
+	s.ledgerRepository.EXPECT().WithTx(gomock.Any()).Return(s.ledgerRepository)
+	s.payoutRepository.EXPECT().WithTx(gomock.Any()).Return(s.payoutRepository)
+	s.ledgerRepository.EXPECT().LockAccount(gomock.Eq(uint(1)))
+}
+
+// expectStatusTransaction runs the usecase's transaction with the payout
+// repository bound to it.
+func (s *payoutUsecaseSuite) expectStatusTransaction() {
+	s.transactor.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(tx r.Tx) error) error {
+		return fn(r.Tx{})
+	})
+	s.payoutRepository.EXPECT().WithTx(gomock.Any()).Return(s.payoutRepository)
+}
+
+func (s *payoutUsecaseSuite) TestGetPayouts() {
+	testCases := []struct {
+		Name         string
+		Body         any
+		Claims       *helper.JWTCustomClaims
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"ok",
+			nil,
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.payoutRepository.EXPECT().GetForUser(
+					gomock.Eq(&[]model.Payout{}),
+					gomock.Eq(uint(1)),
+				)
+			},
+			http.StatusOK,
+		},
+		{
+			"ok",
+			nil,
+			&helper.JWTCustomClaims{ID: 2, Role: "admin"},
+			func() {
+				s.payoutRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Payout{}),
+					gomock.Eq(""),
+				)
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			s.usecase.GetPayouts(testCase.Claims, &[]model.Payout{}, "")
+		})
+	}
+}
+
+func (s *payoutUsecaseSuite) TestCreatePayout() {
+	testCases := []struct {
+		Name         string
+		Body         *request.CreatePayoutRequest
+		Claims       *helper.JWTCustomClaims
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			&request.CreatePayoutRequest{Amount: 1000},
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.bankAccountRepository.EXPECT().FindByUserID(
+					gomock.Eq(&model.BankAccount{}),
+					gomock.Eq(uint(1)),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"bad request",
+			&request.CreatePayoutRequest{Amount: 1000},
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.bankAccountRepository.EXPECT().FindByUserID(
+					gomock.Eq(&model.BankAccount{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.BankAccount{Model: gorm.Model{ID: 1}})
+
+				s.expectTransaction()
+				s.ledgerRepository.EXPECT().GetBalance(
+					gomock.Eq(model.LedgerAccountOrganizer),
+					gomock.Eq(uint(1)),
+				).Return(model.Money(500))
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"rolled back",
+			&request.CreatePayoutRequest{Amount: 1000},
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.bankAccountRepository.EXPECT().FindByUserID(
+					gomock.Eq(&model.BankAccount{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.BankAccount{Model: gorm.Model{ID: 1}})
+
+				s.expectTransaction()
+				s.ledgerRepository.EXPECT().GetBalance(
+					gomock.Eq(model.LedgerAccountOrganizer),
+					gomock.Eq(uint(1)),
+				).Return(model.Money(1000))
+
+				s.payoutRepository.EXPECT().Create(gomock.Any()).Return(errors.New("connection lost"))
+			},
+			http.StatusInternalServerError,
+		},
+		{
+			"ok",
+			&request.CreatePayoutRequest{Amount: 1000},
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.bankAccountRepository.EXPECT().FindByUserID(
+					gomock.Eq(&model.BankAccount{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.BankAccount{Model: gorm.Model{ID: 1}})
+
+				s.expectTransaction()
+				s.ledgerRepository.EXPECT().GetBalance(
+					gomock.Eq(model.LedgerAccountOrganizer),
+					gomock.Eq(uint(1)),
+				).Return(model.Money(1000))
+
+				s.payoutRepository.EXPECT().Create(gomock.Any())
+
+				s.ledgerRepository.EXPECT().Post(gomock.Any())
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			if apiError := s.usecase.CreatePayout(testCase.Claims, &model.Payout{}, testCase.Body); apiError != nil {
+				code, _ := apiError.APIError()
+				s.Equal(testCase.ExpectedCode, code)
+			}
+		})
+	}
+}
+
+func (s *payoutUsecaseSuite) TestCompleteOrRejectPayout() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	createContext := func(endpoint string) echo.Context {
+		req := httptest.NewRequest("", "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetPath(endpoint)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues("1")
+		return ctx
+	}
+
+	testCases := []struct {
+		Name         string
+		Body         any
+		Context      echo.Context
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			nil,
+			createContext("/v1/payouts/:id/complete"),
+			func() {
+				s.payoutRepository.EXPECT().Find(
+					gomock.Eq(&model.Payout{}),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"bad request",
+			nil,
+			createContext("/v1/payouts/:id/complete"),
+			func() {
+				s.payoutRepository.EXPECT().Find(
+					gomock.Eq(&model.Payout{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payout{Model: gorm.Model{ID: 1}, Status: "paid"})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			nil,
+			createContext("/v1/payouts/:id/complete"),
+			func() {
+				s.payoutRepository.EXPECT().Find(
+					gomock.Eq(&model.Payout{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payout{Model: gorm.Model{ID: 1}, Status: "pending", Amount: 1000})
+
+				s.expectStatusTransaction()
+				s.payoutRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq("pending")).Return(true, nil)
+				s.ledgerRepository.EXPECT().WithTx(gomock.Any()).Return(s.ledgerRepository)
+				s.ledgerRepository.EXPECT().Post(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"ok",
+			nil,
+			createContext("/v1/payouts/:id/reject"),
+			func() {
+				s.payoutRepository.EXPECT().Find(
+					gomock.Eq(&model.Payout{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payout{Model: gorm.Model{ID: 1}, Status: "pending", Amount: 1000})
+
+				s.expectStatusTransaction()
+				s.payoutRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq("pending")).Return(true, nil)
+				s.ledgerRepository.EXPECT().WithTx(gomock.Any()).Return(s.ledgerRepository)
+				s.ledgerRepository.EXPECT().Post(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"already processed concurrently",
+			nil,
+			createContext("/v1/payouts/:id/complete"),
+			func() {
+				s.payoutRepository.EXPECT().Find(
+					gomock.Eq(&model.Payout{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payout{Model: gorm.Model{ID: 1}, Status: "pending", Amount: 1000})
+
+				s.expectStatusTransaction()
+				s.payoutRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq("pending")).Return(false, nil)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"post failure",
+			nil,
+			createContext("/v1/payouts/:id/complete"),
+			func() {
+				s.payoutRepository.EXPECT().Find(
+					gomock.Eq(&model.Payout{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Payout{Model: gorm.Model{ID: 1}, Status: "pending", Amount: 1000})
+
+				s.expectStatusTransaction()
+				s.payoutRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq("pending")).Return(true, nil)
+				s.ledgerRepository.EXPECT().WithTx(gomock.Any()).Return(s.ledgerRepository)
+				s.ledgerRepository.EXPECT().Post(gomock.Any()).Return(errors.New("db error"))
+			},
+			http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			apiError := s.usecase.CompleteOrRejectPayout(admin, testCase.Context, &model.Payout{})
+			if testCase.ExpectedCode == http.StatusOK {
+				s.Nil(apiError)
+				return
+			}
+			s.NotNil(apiError)
+			code, _ := apiError.APIError()
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *payoutUsecaseSuite) TestCompleteOrRejectPayoutUnauthorized() {
+	claims := &helper.JWTCustomClaims{ID: 2, Role: "organizer"}
+
+	for _, apiError := range []helper.APIError{
+		s.usecase.CompleteOrRejectPayout(claims, nil, &model.Payout{}),
+	} {
+		s.NotNil(apiError)
+		code, _ := apiError.APIError()
+		s.Equal(http.StatusUnauthorized, code)
+	}
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+	"github.com/andikabahari/eoplatform/search"
+)
+
+type RelatedServiceUsecase interface {
+	GetRelatedServices(related *model.RelatedServices, id string) helper.APIError
+	RefreshCooccurrences()
+}
+
+type relatedServiceUsecase struct {
+	serviceRepository             r.ServiceRepository
+	serviceCooccurrenceRepository r.ServiceCooccurrenceRepository
+	searchIndex                   search.Index
+}
+
+func NewRelatedServiceUsecase(
+	serviceRepository r.ServiceRepository,
+	serviceCooccurrenceRepository r.ServiceCooccurrenceRepository,
+	searchIndex search.Index,
+) RelatedServiceUsecase {
+	return &relatedServiceUsecase{
+		serviceRepository,
+		serviceCooccurrenceRepository,
+		searchIndex,
+	}
+}
+
+const relatedServicesLimit = 6
+
+// similarQueryWords caps the description words searched for similar
+// services, since every query term is matched against the whole index.
+const similarQueryWords = 10
+
+func (u *relatedServiceUsecase) GetRelatedServices(related *model.RelatedServices, id string) helper.APIError {
+	service := model.Service{}
+	u.serviceRepository.Find(&service, id)
+
+	if service.ID == 0 || service.Status != model.ServiceStatusPublished {
+		return helper.NewAPIError(http.StatusNotFound, "service not found")
+	}
+
+	related.BookedTogether = make([]model.Service, 0)
+	if serviceIDs := u.serviceCooccurrenceRepository.GetRelatedServiceIDs(service.ID, relatedServicesLimit); len(serviceIDs) > 0 {
+		u.serviceRepository.Get(&related.BookedTogether, &model.ServiceFilter{
+			IDs:    serviceIDs,
+			Status: model.ServiceStatusPublished,
+			Sort:   model.ServiceSortRelevance,
+		})
+	}
+
+	related.Similar = u.similarServices(service, related.BookedTogether)
+
+	return nil
+}
+
+// similarServices returns published services in the same category costing
+// between half and twice as much as service, those whose name and main
+// description words read most alike first. Until the search index is built
+// they are ranked by popularity instead. The service itself and the ones
+// listed as booked together are left out.
+func (u *relatedServiceUsecase) similarServices(service model.Service, bookedTogether []model.Service) []model.Service {
+	excluded := map[uint]bool{service.ID: true}
+	for _, s := range bookedTogether {
+		excluded[s.ID] = true
+	}
+
+	filter := model.ServiceFilter{
+		Status:   model.ServiceStatusPublished,
+		MinPrice: service.Cost / 2,
+		MaxPrice: service.Cost * 2,
+		Sort:     model.ServiceSortPopular,
+		Limit:    relatedServicesLimit + len(excluded),
+	}
+	if service.CategoryID != nil {
+		filter.CategoryIDs = []uint{*service.CategoryID}
+	}
+
+	if u.searchIndex.Ready() {
+		query := service.Name + " " + strings.Join(search.TopWords(service.Description, similarQueryWords), " ")
+		if hits := u.searchIndex.Search(query); len(hits) > 0 {
+			filter.IDs = make([]uint, 0, len(hits))
+			for _, hit := range hits {
+				filter.IDs = append(filter.IDs, hit.ID)
+			}
+			filter.Sort = model.ServiceSortRelevance
+		}
+	}
+
+	candidates := make([]model.Service, 0)
+	u.serviceRepository.Get(&candidates, &filter)
+
+	similar := make([]model.Service, 0, relatedServicesLimit)
+	for _, candidate := range candidates {
+		if excluded[candidate.ID] || len(similar) == relatedServicesLimit {
+			continue
+		}
+		similar = append(similar, candidate)
+	}
+
+	return similar
+}
+
+func (u *relatedServiceUsecase) RefreshCooccurrences() {
+	u.serviceCooccurrenceRepository.Refresh()
+}
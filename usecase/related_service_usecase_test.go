@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/model"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/andikabahari/eoplatform/search"
+	msearch "github.com/andikabahari/eoplatform/search/mock_search"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type relatedServiceUsecaseSuite struct {
+	suite.Suite
+
+	ctrl                          *gomock.Controller
+	serviceRepository             *mr.MockServiceRepository
+	serviceCooccurrenceRepository *mr.MockServiceCooccurrenceRepository
+	searchIndex                   *msearch.MockIndex
+
+	usecase RelatedServiceUsecase
+}
+
+func (s *relatedServiceUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.serviceRepository = mr.NewMockServiceRepository(s.ctrl)
+	s.serviceCooccurrenceRepository = mr.NewMockServiceCooccurrenceRepository(s.ctrl)
+	s.searchIndex = msearch.NewMockIndex(s.ctrl)
+
+	s.usecase = NewRelatedServiceUsecase(s.serviceRepository, s.serviceCooccurrenceRepository, s.searchIndex)
+}
+
+func (s *relatedServiceUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestRelatedServiceUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(relatedServiceUsecaseSuite))
+}
+
+func (s *relatedServiceUsecaseSuite) TestGetRelatedServices() {
+	categoryID := uint(2)
+	service := model.Service{
+		Model:       gorm.Model{ID: 1},
+		CategoryID:  &categoryID,
+		Name:        "Wedding Photo",
+		Cost:        1_000_000,
+		Description: "Full day coverage",
+		Status:      model.ServiceStatusPublished,
+	}
+
+	testCases := []struct {
+		Name            string
+		ExpectedFunc    func()
+		ExpectedCode    int
+		ExpectedSimilar []uint
+	}{
+		{
+			"not found",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1"))
+			},
+			http.StatusNotFound,
+			nil,
+		},
+		{
+			"draft",
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusDraft})
+			},
+			http.StatusNotFound,
+			nil,
+		},
+		{
+			"index not ready",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, service)
+
+				s.serviceCooccurrenceRepository.EXPECT().GetRelatedServiceIDs(gomock.Eq(uint(1)), gomock.Eq(6)).Return([]uint{4})
+				s.serviceRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Service{}),
+					gomock.Eq(&model.ServiceFilter{
+						IDs:    []uint{4},
+						Status: model.ServiceStatusPublished,
+						Sort:   model.ServiceSortRelevance,
+					}),
+				).SetArg(0, []model.Service{{Model: gorm.Model{ID: 4}}})
+
+				s.searchIndex.EXPECT().Ready().Return(false)
+				s.serviceRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Service{}),
+					gomock.Eq(&model.ServiceFilter{
+						Status:      model.ServiceStatusPublished,
+						CategoryIDs: []uint{2},
+						MinPrice:    500_000,
+						MaxPrice:    2_000_000,
+						Sort:        model.ServiceSortPopular,
+						Limit:       8,
+					}),
+				).SetArg(0, []model.Service{{Model: gorm.Model{ID: 4}}, {Model: gorm.Model{ID: 1}}, {Model: gorm.Model{ID: 3}}})
+			},
+			http.StatusOK,
+			[]uint{3},
+		},
+		{
+			"index ready",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, service)
+
+				s.serviceCooccurrenceRepository.EXPECT().GetRelatedServiceIDs(gomock.Eq(uint(1)), gomock.Eq(6)).Return([]uint{})
+
+				s.searchIndex.EXPECT().Ready().Return(true)
+				s.searchIndex.EXPECT().Search(gomock.Eq("Wedding Photo full day coverage")).Return([]search.Hit{
+					{ID: 1, Score: 9},
+					{ID: 5, Score: 3},
+				})
+				s.serviceRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Service{}),
+					gomock.Eq(&model.ServiceFilter{
+						IDs:         []uint{1, 5},
+						Status:      model.ServiceStatusPublished,
+						CategoryIDs: []uint{2},
+						MinPrice:    500_000,
+						MaxPrice:    2_000_000,
+						Sort:        model.ServiceSortRelevance,
+						Limit:       7,
+					}),
+				).SetArg(0, []model.Service{{Model: gorm.Model{ID: 1}}, {Model: gorm.Model{ID: 5}}})
+			},
+			http.StatusOK,
+			[]uint{5},
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			related := model.RelatedServices{}
+			code := http.StatusOK
+			if apiError := s.usecase.GetRelatedServices(&related, "1"); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+
+			if testCase.ExpectedSimilar != nil {
+				similar := make([]uint, 0)
+				for _, service := range related.Similar {
+					similar = append(similar, service.ID)
+				}
+				s.Equal(testCase.ExpectedSimilar, similar)
+			}
+		})
+	}
+}
+
+func (s *relatedServiceUsecaseSuite) TestRefreshCooccurrences() {
+	s.serviceCooccurrenceRepository.EXPECT().Refresh()
+	s.usecase.RefreshCooccurrences()
+}
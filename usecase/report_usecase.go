@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+)
+
+type ReportUsecase interface {
+	GetRevenue(claims *helper.JWTCustomClaims, revenues *[]model.Revenue, from, to string) helper.APIError
+}
+
+type reportUsecase struct {
+	paymentRepository r.PaymentRepository
+	userRepository    r.UserRepository
+}
+
+func NewReportUsecase(paymentRepository r.PaymentRepository, userRepository r.UserRepository) ReportUsecase {
+	return &reportUsecase{paymentRepository, userRepository}
+}
+
+func (u *reportUsecase) GetRevenue(claims *helper.JWTCustomClaims, revenues *[]model.Revenue, from, to string) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if from != "" {
+		if _, err := time.Parse("2006-01-02", from); err != nil {
+			return helper.NewAPIError(http.StatusBadRequest, "invalid from date")
+		}
+	}
+	if to != "" {
+		date, err := time.Parse("2006-01-02", to)
+		if err != nil {
+			return helper.NewAPIError(http.StatusBadRequest, "invalid to date")
+		}
+		to = date.AddDate(0, 0, 1).Format("2006-01-02")
+	}
+
+	u.paymentRepository.GetRevenue(revenues, from, to)
+
+	for i := range *revenues {
+		u.userRepository.Find(&(*revenues)[i].User, (*revenues)[i].UserID)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type reportUsecaseSuite struct {
+	suite.Suite
+
+	ctrl              *gomock.Controller
+	paymentRepository *mr.MockPaymentRepository
+	userRepository    *mr.MockUserRepository
+
+	usecase ReportUsecase
+}
+
+func (s *reportUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.paymentRepository = mr.NewMockPaymentRepository(s.ctrl)
+	s.userRepository = mr.NewMockUserRepository(s.ctrl)
+
+	s.usecase = NewReportUsecase(s.paymentRepository, s.userRepository)
+}
+
+func (s *reportUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestReportUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(reportUsecaseSuite))
+}
+
+func (s *reportUsecaseSuite) TestGetRevenue() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	testCases := []struct {
+		Name         string
+		From         string
+		To           string
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"bad request",
+			"yesterday",
+			"",
+			func() {},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			"2022-12-01",
+			"2022-12-31",
+			func() {
+				s.paymentRepository.EXPECT().GetRevenue(
+					gomock.Eq(&[]model.Revenue{}),
+					gomock.Eq("2022-12-01"),
+					gomock.Eq("2023-01-01"),
+				).SetArg(0, []model.Revenue{{UserID: 1, Gross: 1000, Commission: 100, Earning: 900}})
+
+				s.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq(uint(1)))
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			if apiError := s.usecase.GetRevenue(admin, &[]model.Revenue{}, testCase.From, testCase.To); apiError != nil {
+				code, _ := apiError.APIError()
+				s.Equal(testCase.ExpectedCode, code)
+			}
+		})
+	}
+}
+
+func (s *reportUsecaseSuite) TestGetRevenueUnauthorized() {
+	claims := &helper.JWTCustomClaims{ID: 2, Role: "organizer"}
+
+	for _, apiError := range []helper.APIError{
+		s.usecase.GetRevenue(claims, &[]model.Revenue{}, "", ""),
+	} {
+		s.NotNil(apiError)
+		code, _ := apiError.APIError()
+		s.Equal(http.StatusUnauthorized, code)
+	}
+}
@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/storage"
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	maxServiceImages     = 10
+	maxServiceImageSize  = 5 << 20
+	serviceThumbnailSize = 320
+)
+
+type ServiceImageUsecase interface {
+	UploadServiceImage(ctx echo.Context, image *model.ServiceImage) helper.APIError
+	UpdateServiceImage(ctx echo.Context, image *model.ServiceImage, req *request.UpdateServiceImageRequest) helper.APIError
+	ReorderServiceImages(ctx echo.Context, images *[]model.ServiceImage, req *request.ReorderServiceImagesRequest) helper.APIError
+	DeleteServiceImage(ctx echo.Context, image *model.ServiceImage) helper.APIError
+}
+
+type serviceImageUsecase struct {
+	serviceRepository      r.ServiceRepository
+	serviceImageRepository r.ServiceImageRepository
+	storage                storage.Storage
+}
+
+func NewServiceImageUsecase(
+	serviceRepository r.ServiceRepository,
+	serviceImageRepository r.ServiceImageRepository,
+	storage storage.Storage,
+) ServiceImageUsecase {
+	return &serviceImageUsecase{
+		serviceRepository,
+		serviceImageRepository,
+		storage,
+	}
+}
+
+func (u *serviceImageUsecase) UploadServiceImage(ctx echo.Context, image *model.ServiceImage) helper.APIError {
+	service := model.Service{}
+	if apiError := u.findOwnService(ctx, &service); apiError != nil {
+		return apiError
+	}
+
+	if len(service.Images) >= maxServiceImages {
+		return helper.NewAPIError(http.StatusBadRequest, fmt.Sprintf("service already has %d images", maxServiceImages))
+	}
+
+	caption := ctx.FormValue("caption")
+	if len([]rune(caption)) > 200 {
+		return helper.NewAPIError(http.StatusBadRequest, "caption must not exceed 200 characters")
+	}
+
+	fileHeader, err := ctx.FormFile("image")
+	if err != nil {
+		return helper.NewAPIError(http.StatusBadRequest, "image is required")
+	}
+
+	if fileHeader.Size > maxServiceImageSize {
+		return helper.NewAPIError(http.StatusBadRequest, "image must not exceed 5MB")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxServiceImageSize+1))
+	if err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+
+	contentType := http.DetectContentType(data)
+	extension, ok := imageExtensions[contentType]
+	if !ok {
+		return helper.NewAPIError(http.StatusBadRequest, "image must be a JPEG or PNG image")
+	}
+
+	thumbnail, err := helper.CreateThumbnail(bytes.NewReader(data), serviceThumbnailSize)
+	if errors.Is(err, helper.ErrImageTooLarge) {
+		return helper.NewAPIError(http.StatusBadRequest, "image dimensions are too large")
+	}
+	if err != nil {
+		return helper.NewAPIError(http.StatusBadRequest, "image could not be read")
+	}
+
+	name := fmt.Sprintf("services/%d/%d", service.ID, time.Now().UnixNano())
+
+	image.Key = name + extension
+	image.URL, err = u.storage.Put(image.Key, bytes.NewReader(data), contentType)
+	if err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+
+	image.ThumbnailKey = name + "-thumb.jpg"
+	image.ThumbnailURL, err = u.storage.Put(image.ThumbnailKey, bytes.NewReader(thumbnail), "image/jpeg")
+	if err != nil {
+		log.Printf("Error: %s", err)
+		u.deleteFiles(image.Key)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+
+	image.ServiceID = service.ID
+	image.Caption = caption
+	image.Position = 0
+	for _, other := range service.Images {
+		if other.Position >= image.Position {
+			image.Position = other.Position + 1
+		}
+	}
+
+	// The first image of a service always becomes its cover.
+	image.IsCover = len(service.Images) == 0 || ctx.FormValue("cover") == "true"
+	if image.IsCover {
+		u.clearCover(service.Images)
+	}
+
+	u.serviceImageRepository.Save(image)
+
+	return nil
+}
+
+func (u *serviceImageUsecase) UpdateServiceImage(ctx echo.Context, image *model.ServiceImage, req *request.UpdateServiceImageRequest) helper.APIError {
+	service := model.Service{}
+	if apiError := u.findOwnService(ctx, &service); apiError != nil {
+		return apiError
+	}
+
+	u.serviceImageRepository.Find(image, service.ID, ctx.Param("imageId"))
+
+	if image.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "image not found")
+	}
+
+	// A service keeps exactly one cover, so it can only be moved to
+	// another image, not removed.
+	if req.IsCover && !image.IsCover {
+		u.clearCover(service.Images)
+		image.IsCover = true
+	}
+
+	image.Caption = req.Caption
+	u.serviceImageRepository.Save(image)
+
+	return nil
+}
+
+func (u *serviceImageUsecase) ReorderServiceImages(ctx echo.Context, images *[]model.ServiceImage, req *request.ReorderServiceImagesRequest) helper.APIError {
+	service := model.Service{}
+	if apiError := u.findOwnService(ctx, &service); apiError != nil {
+		return apiError
+	}
+
+	positions := make(map[uint]int)
+	for i, id := range req.ImageIDs {
+		positions[id] = i
+	}
+
+	if len(positions) != len(req.ImageIDs) || len(positions) != len(service.Images) {
+		return helper.NewAPIError(http.StatusBadRequest, "image_ids must list every image of the service once")
+	}
+
+	for _, image := range service.Images {
+		if _, ok := positions[image.ID]; !ok {
+			return helper.NewAPIError(http.StatusBadRequest, "image_ids must list every image of the service once")
+		}
+	}
+
+	*images = make([]model.ServiceImage, len(service.Images))
+	for _, image := range service.Images {
+		position := positions[image.ID]
+		if image.Position != position {
+			image.Position = position
+			u.serviceImageRepository.Save(&image)
+		}
+		(*images)[position] = image
+	}
+
+	return nil
+}
+
+func (u *serviceImageUsecase) DeleteServiceImage(ctx echo.Context, image *model.ServiceImage) helper.APIError {
+	service := model.Service{}
+	if apiError := u.findOwnService(ctx, &service); apiError != nil {
+		return apiError
+	}
+
+	u.serviceImageRepository.Find(image, service.ID, ctx.Param("imageId"))
+
+	if image.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "image not found")
+	}
+
+	u.serviceImageRepository.Delete(image)
+	u.deleteFiles(image.Key, image.ThumbnailKey)
+
+	if image.IsCover {
+		for _, other := range service.Images {
+			if other.ID != image.ID {
+				other.IsCover = true
+				u.serviceImageRepository.Save(&other)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func (u *serviceImageUsecase) findOwnService(ctx echo.Context, service *model.Service) helper.APIError {
+	user := ctx.Get("user").(*jwt.Token)
+	claims := user.Claims.(*helper.JWTCustomClaims)
+
+	u.serviceRepository.Find(service, ctx.Param("id"))
+
+	if service.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "service not found")
+	}
+
+	if service.UserID != claims.ID {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	return nil
+}
+
+func (u *serviceImageUsecase) clearCover(images []model.ServiceImage) {
+	for _, image := range images {
+		if image.IsCover {
+			image.IsCover = false
+			u.serviceImageRepository.Save(&image)
+		}
+	}
+}
+
+// deleteFiles removes stored files on a best-effort basis; a leftover file
+// is harmless once nothing refers to it.
+func (u *serviceImageUsecase) deleteFiles(keys ...string) {
+	for _, key := range keys {
+		if err := u.storage.Delete(key); err != nil {
+			log.Printf("Error: %s", err)
+		}
+	}
+}
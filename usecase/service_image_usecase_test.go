@@ -0,0 +1,425 @@
+package usecase
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/andikabahari/eoplatform/request"
+	ms "github.com/andikabahari/eoplatform/storage/mock_storage"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type serviceImageUsecaseSuite struct {
+	suite.Suite
+
+	ctrl                   *gomock.Controller
+	serviceRepository      *mr.MockServiceRepository
+	serviceImageRepository *mr.MockServiceImageRepository
+	storage                *ms.MockStorage
+
+	usecase ServiceImageUsecase
+}
+
+func (s *serviceImageUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.serviceRepository = mr.NewMockServiceRepository(s.ctrl)
+	s.serviceImageRepository = mr.NewMockServiceImageRepository(s.ctrl)
+	s.storage = ms.NewMockStorage(s.ctrl)
+
+	s.usecase = NewServiceImageUsecase(
+		s.serviceRepository,
+		s.serviceImageRepository,
+		s.storage,
+	)
+}
+
+func (s *serviceImageUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestServiceImageUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(serviceImageUsecaseSuite))
+}
+
+func (s *serviceImageUsecaseSuite) TestUploadServiceImage() {
+	createContext := func(token *jwt.Token, file []byte) echo.Context {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		writer.WriteField("caption", "Main hall")
+		if file != nil {
+			part, _ := writer.CreateFormFile("image", "image")
+			part.Write(file)
+		}
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.Set("user", token)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues("1")
+		return ctx
+	}
+
+	buf := new(bytes.Buffer)
+	png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 640, 480)))
+	photo := buf.Bytes()
+
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+	service := model.Service{Model: gorm.Model{ID: 1}, UserID: 1}
+
+	full := service
+	full.Images = make([]model.ServiceImage, maxServiceImages)
+
+	testCases := []struct {
+		Name         string
+		Context      echo.Context
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			createContext(organizer, photo),
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"unauthorized",
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 2, Role: "organizer"},
+			), photo),
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+			},
+			http.StatusUnauthorized,
+		},
+		{
+			"bad request",
+			createContext(organizer, photo),
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, full)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"bad request",
+			createContext(organizer, []byte("not an image")),
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			createContext(organizer, photo),
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+
+				s.storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq("image/png")).Return("/uploads/services/1/a.png", nil)
+				s.storage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq("image/jpeg")).Return("/uploads/services/1/a-thumb.jpg", nil)
+
+				s.serviceImageRepository.EXPECT().Save(gomock.Any()).Do(func(image *model.ServiceImage) {
+					s.Equal(uint(1), image.ServiceID)
+					s.Equal("Main hall", image.Caption)
+					s.Equal("/uploads/services/1/a.png", image.URL)
+					s.Equal("/uploads/services/1/a-thumb.jpg", image.ThumbnailURL)
+					s.True(image.IsCover)
+				})
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			if apiError := s.usecase.UploadServiceImage(testCase.Context, &model.ServiceImage{}); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *serviceImageUsecaseSuite) TestUpdateServiceImage() {
+	createContext := func(token *jwt.Token) echo.Context {
+		req := httptest.NewRequest("", "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.Set("user", token)
+		ctx.SetParamNames("id", "imageId")
+		ctx.SetParamValues("1", "2")
+		return ctx
+	}
+
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+	service := model.Service{
+		Model:  gorm.Model{ID: 1},
+		UserID: 1,
+		Images: []model.ServiceImage{
+			{Model: gorm.Model{ID: 1}, ServiceID: 1, IsCover: true},
+			{Model: gorm.Model{ID: 2}, ServiceID: 1, Position: 1},
+		},
+	}
+
+	testCases := []struct {
+		Name         string
+		Body         *request.UpdateServiceImageRequest
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			&request.UpdateServiceImageRequest{},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+
+				s.serviceImageRepository.EXPECT().Find(
+					gomock.Eq(&model.ServiceImage{}),
+					gomock.Eq(uint(1)),
+					gomock.Eq("2"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			&request.UpdateServiceImageRequest{Caption: "Stage", IsCover: true},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+
+				s.serviceImageRepository.EXPECT().Find(
+					gomock.Eq(&model.ServiceImage{}),
+					gomock.Eq(uint(1)),
+					gomock.Eq("2"),
+				).SetArg(0, service.Images[1])
+
+				gomock.InOrder(
+					s.serviceImageRepository.EXPECT().Save(gomock.Any()).Do(func(image *model.ServiceImage) {
+						s.Equal(uint(1), image.ID)
+						s.False(image.IsCover)
+					}),
+					s.serviceImageRepository.EXPECT().Save(gomock.Any()).Do(func(image *model.ServiceImage) {
+						s.Equal(uint(2), image.ID)
+						s.Equal("Stage", image.Caption)
+						s.True(image.IsCover)
+					}),
+				)
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			if apiError := s.usecase.UpdateServiceImage(createContext(organizer), &model.ServiceImage{}, testCase.Body); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *serviceImageUsecaseSuite) TestReorderServiceImages() {
+	createContext := func(token *jwt.Token) echo.Context {
+		req := httptest.NewRequest("", "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.Set("user", token)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues("1")
+		return ctx
+	}
+
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+	service := model.Service{
+		Model:  gorm.Model{ID: 1},
+		UserID: 1,
+		Images: []model.ServiceImage{
+			{Model: gorm.Model{ID: 1}, ServiceID: 1, IsCover: true},
+			{Model: gorm.Model{ID: 2}, ServiceID: 1, Position: 1},
+		},
+	}
+
+	testCases := []struct {
+		Name         string
+		Body         *request.ReorderServiceImagesRequest
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"bad request",
+			&request.ReorderServiceImagesRequest{ImageIDs: []uint{2, 3}},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"bad request",
+			&request.ReorderServiceImagesRequest{ImageIDs: []uint{2, 2}},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			&request.ReorderServiceImagesRequest{ImageIDs: []uint{2, 1}},
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+
+				s.serviceImageRepository.EXPECT().Save(gomock.Any()).Times(2)
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			images := make([]model.ServiceImage, 0)
+			if apiError := s.usecase.ReorderServiceImages(createContext(organizer), &images, testCase.Body); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+			if code == http.StatusOK {
+				s.Equal(uint(2), images[0].ID)
+				s.Equal(uint(1), images[1].ID)
+			}
+		})
+	}
+}
+
+func (s *serviceImageUsecaseSuite) TestDeleteServiceImage() {
+	createContext := func(token *jwt.Token) echo.Context {
+		req := httptest.NewRequest("", "/", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.Set("user", token)
+		ctx.SetParamNames("id", "imageId")
+		ctx.SetParamValues("1", "1")
+		return ctx
+	}
+
+	organizer := jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"})
+	service := model.Service{
+		Model:  gorm.Model{ID: 1},
+		UserID: 1,
+		Images: []model.ServiceImage{
+			{Model: gorm.Model{ID: 1}, ServiceID: 1, Key: "a.png", ThumbnailKey: "a-thumb.jpg", IsCover: true},
+			{Model: gorm.Model{ID: 2}, ServiceID: 1, Position: 1},
+		},
+	}
+
+	testCases := []struct {
+		Name         string
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+
+				s.serviceImageRepository.EXPECT().Find(
+					gomock.Eq(&model.ServiceImage{}),
+					gomock.Eq(uint(1)),
+					gomock.Eq("1"),
+				)
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, service)
+
+				s.serviceImageRepository.EXPECT().Find(
+					gomock.Eq(&model.ServiceImage{}),
+					gomock.Eq(uint(1)),
+					gomock.Eq("1"),
+				).SetArg(0, service.Images[0])
+
+				s.serviceImageRepository.EXPECT().Delete(gomock.Any())
+				s.storage.EXPECT().Delete(gomock.Eq("a.png"))
+				s.storage.EXPECT().Delete(gomock.Eq("a-thumb.jpg"))
+				s.serviceImageRepository.EXPECT().Save(gomock.Any()).Do(func(image *model.ServiceImage) {
+					s.Equal(uint(2), image.ID)
+					s.True(image.IsCover)
+				})
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			if apiError := s.usecase.DeleteServiceImage(createContext(organizer), &model.ServiceImage{}); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
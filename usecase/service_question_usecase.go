@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/moderation"
+	r "github.com/andikabahari/eoplatform/repository"
+	"github.com/andikabahari/eoplatform/request"
+)
+
+type ServiceQuestionUsecase interface {
+	GetQuestions(questions *[]model.ServiceQuestion, serviceID string) helper.APIError
+	AskQuestion(claims *helper.JWTCustomClaims, question *model.ServiceQuestion, serviceID string, req *request.CreateServiceQuestionRequest) helper.APIError
+	AnswerQuestion(claims *helper.JWTCustomClaims, question *model.ServiceQuestion, serviceID, id string, req *request.AnswerServiceQuestionRequest) helper.APIError
+	ModerateQuestion(claims *helper.JWTCustomClaims, question *model.ServiceQuestion, serviceID, id string, req *request.ModerateServiceQuestionRequest) helper.APIError
+}
+
+type serviceQuestionUsecase struct {
+	serviceRepository         r.ServiceRepository
+	serviceQuestionRepository r.ServiceQuestionRepository
+	userRepository            r.UserRepository
+	moderator                 moderation.Moderator
+}
+
+func NewServiceQuestionUsecase(
+	serviceRepository r.ServiceRepository,
+	serviceQuestionRepository r.ServiceQuestionRepository,
+	userRepository r.UserRepository,
+	moderator moderation.Moderator,
+) ServiceQuestionUsecase {
+	return &serviceQuestionUsecase{
+		serviceRepository,
+		serviceQuestionRepository,
+		userRepository,
+		moderator,
+	}
+}
+
+func (u *serviceQuestionUsecase) GetQuestions(questions *[]model.ServiceQuestion, serviceID string) helper.APIError {
+	service := model.Service{}
+	u.serviceRepository.Find(&service, serviceID)
+
+	if service.ID == 0 || service.Status == model.ServiceStatusDraft {
+		return helper.NewAPIError(http.StatusNotFound, "service not found")
+	}
+
+	u.serviceQuestionRepository.Get(questions, service.ID, model.QuestionStatusPublished)
+
+	return nil
+}
+
+func (u *serviceQuestionUsecase) AskQuestion(claims *helper.JWTCustomClaims, question *model.ServiceQuestion, serviceID string, req *request.CreateServiceQuestionRequest) helper.APIError {
+	service := model.Service{}
+	u.serviceRepository.Find(&service, serviceID)
+
+	if service.ID == 0 || service.Status != model.ServiceStatusPublished {
+		return helper.NewAPIError(http.StatusNotFound, "service not found")
+	}
+
+	question.ServiceID = service.ID
+	question.UserID = claims.ID
+	question.Question = req.Question
+
+	switch u.moderator.Moderate(req.Question) {
+	case moderation.Reject:
+		return helper.NewAPIError(http.StatusBadRequest, "question was rejected by moderation")
+	case moderation.Hold:
+		question.Status = model.QuestionStatusPending
+	default:
+		question.Status = model.QuestionStatusPublished
+	}
+
+	u.serviceQuestionRepository.Save(question)
+	u.userRepository.Find(&question.User, claims.ID)
+
+	if question.Status == model.QuestionStatusPublished {
+		go notifyNewQuestion(service, *question)
+	}
+
+	return nil
+}
+
+func (u *serviceQuestionUsecase) AnswerQuestion(claims *helper.JWTCustomClaims, question *model.ServiceQuestion, serviceID, id string, req *request.AnswerServiceQuestionRequest) helper.APIError {
+	service := model.Service{}
+	u.serviceRepository.Find(&service, serviceID)
+
+	if service.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "service not found")
+	}
+
+	if service.UserID != claims.ID {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.serviceQuestionRepository.Find(question, service.ID, id)
+
+	if question.ID == 0 || question.Status == model.QuestionStatusHidden {
+		return helper.NewAPIError(http.StatusNotFound, "question not found")
+	}
+
+	now := time.Now()
+	question.Answer = req.Answer
+	question.AnsweredAt = &now
+
+	u.serviceQuestionRepository.Save(question)
+
+	return nil
+}
+
+// ModerateQuestion lets an admin publish a question moderation held back,
+// or hide one that should not be shown.
+func (u *serviceQuestionUsecase) ModerateQuestion(claims *helper.JWTCustomClaims, question *model.ServiceQuestion, serviceID, id string, req *request.ModerateServiceQuestionRequest) helper.APIError {
+	if claims.Role != "admin" {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	service := model.Service{}
+	u.serviceRepository.Find(&service, serviceID)
+
+	if service.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "service not found")
+	}
+
+	u.serviceQuestionRepository.Find(question, service.ID, id)
+
+	if question.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "question not found")
+	}
+
+	wasPending := question.Status == model.QuestionStatusPending
+	question.Status = req.Status
+
+	u.serviceQuestionRepository.Save(question)
+
+	if wasPending && question.Status == model.QuestionStatusPublished {
+		go notifyNewQuestion(service, *question)
+	}
+
+	return nil
+}
+
+// notifyNewQuestion emails the organizer about a new question on their
+// service. It is meant to run in its own goroutine, so failures are only
+// logged.
+func notifyNewQuestion(service model.Service, question model.ServiceQuestion) {
+	if service.Email == "" {
+		return
+	}
+
+	message := fmt.Sprintf("Subject: New question about %s\r\n\r\n"+
+		"%s asked about %s:\r\n\r\n%s\r\n",
+		service.Name, question.User.Name, service.Name, question.Question)
+
+	if err := helper.SendEmail([]string{service.Email}, message); err != nil {
+		log.Printf("Error: %s", err)
+	}
+}
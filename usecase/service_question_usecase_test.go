@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/andikabahari/eoplatform/helper"
+	"github.com/andikabahari/eoplatform/model"
+	"github.com/andikabahari/eoplatform/moderation"
+	mm "github.com/andikabahari/eoplatform/moderation/mock_moderation"
+	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
+	"github.com/andikabahari/eoplatform/request"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type serviceQuestionUsecaseSuite struct {
+	suite.Suite
+
+	ctrl                      *gomock.Controller
+	serviceRepository         *mr.MockServiceRepository
+	serviceQuestionRepository *mr.MockServiceQuestionRepository
+	userRepository            *mr.MockUserRepository
+	moderator                 *mm.MockModerator
+
+	usecase ServiceQuestionUsecase
+}
+
+func (s *serviceQuestionUsecaseSuite) SetupSuite() {
+	os.Setenv("APP_ENV", "production")
+
+	s.ctrl = gomock.NewController(s.T())
+	s.serviceRepository = mr.NewMockServiceRepository(s.ctrl)
+	s.serviceQuestionRepository = mr.NewMockServiceQuestionRepository(s.ctrl)
+	s.userRepository = mr.NewMockUserRepository(s.ctrl)
+	s.moderator = mm.NewMockModerator(s.ctrl)
+
+	s.usecase = NewServiceQuestionUsecase(s.serviceRepository, s.serviceQuestionRepository, s.userRepository, s.moderator)
+}
+
+func (s *serviceQuestionUsecaseSuite) TearDownSuite() {
+	s.ctrl.Finish()
+}
+
+func TestServiceQuestionUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(serviceQuestionUsecaseSuite))
+}
+
+func (s *serviceQuestionUsecaseSuite) TestGetQuestions() {
+	testCases := []struct {
+		Name         string
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusPublished})
+
+				s.serviceQuestionRepository.EXPECT().Get(
+					gomock.Eq(&[]model.ServiceQuestion{}),
+					gomock.Eq(uint(1)),
+					gomock.Eq(model.QuestionStatusPublished),
+				)
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			code := http.StatusOK
+			if apiError := s.usecase.GetQuestions(&[]model.ServiceQuestion{}, "1"); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *serviceQuestionUsecaseSuite) TestAskQuestion() {
+	published := model.Service{Model: gorm.Model{ID: 1}, UserID: 2, Status: model.ServiceStatusPublished}
+
+	testCases := []struct {
+		Name           string
+		ExpectedFunc   func()
+		ExpectedCode   int
+		ExpectedStatus string
+	}{
+		{
+			"draft",
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusDraft})
+			},
+			http.StatusNotFound,
+			"",
+		},
+		{
+			"rejected",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, published)
+				s.moderator.EXPECT().Moderate(gomock.Eq("Is parking included?")).Return(moderation.Reject)
+			},
+			http.StatusBadRequest,
+			"",
+		},
+		{
+			"held",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, published)
+				s.moderator.EXPECT().Moderate(gomock.Eq("Is parking included?")).Return(moderation.Hold)
+				s.serviceQuestionRepository.EXPECT().Save(gomock.Any())
+				s.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq(uint(3)))
+			},
+			http.StatusOK,
+			model.QuestionStatusPending,
+		},
+		{
+			"ok",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, published)
+				s.moderator.EXPECT().Moderate(gomock.Eq("Is parking included?")).Return(moderation.Approve)
+				s.serviceQuestionRepository.EXPECT().Save(gomock.Any()).Do(func(question *model.ServiceQuestion) {
+					s.Equal(uint(1), question.ServiceID)
+					s.Equal(uint(3), question.UserID)
+				})
+				s.userRepository.EXPECT().Find(gomock.Any(), gomock.Eq(uint(3)))
+			},
+			http.StatusOK,
+			model.QuestionStatusPublished,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			claims := &helper.JWTCustomClaims{ID: 3, Role: "customer"}
+			req := &request.CreateServiceQuestionRequest{Question: "Is parking included?"}
+			question := model.ServiceQuestion{}
+			code := http.StatusOK
+			if apiError := s.usecase.AskQuestion(claims, &question, "1", req); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+			s.Equal(testCase.ExpectedStatus, question.Status)
+		})
+	}
+}
+
+func (s *serviceQuestionUsecaseSuite) TestAnswerQuestion() {
+	service := model.Service{Model: gorm.Model{ID: 1}, UserID: 2, Status: model.ServiceStatusPublished}
+
+	testCases := []struct {
+		Name         string
+		Claims       *helper.JWTCustomClaims
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"unauthorized",
+			&helper.JWTCustomClaims{ID: 5, Role: "organizer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, service)
+			},
+			http.StatusUnauthorized,
+		},
+		{
+			"question not found",
+			&helper.JWTCustomClaims{ID: 2, Role: "organizer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, service)
+				s.serviceQuestionRepository.EXPECT().Find(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("4"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			&helper.JWTCustomClaims{ID: 2, Role: "organizer"},
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, service)
+				s.serviceQuestionRepository.EXPECT().Find(
+					gomock.Any(),
+					gomock.Eq(uint(1)),
+					gomock.Eq("4"),
+				).SetArg(0, model.ServiceQuestion{Model: gorm.Model{ID: 4}, Status: model.QuestionStatusPublished})
+				s.serviceQuestionRepository.EXPECT().Save(gomock.Any()).Do(func(question *model.ServiceQuestion) {
+					s.Equal("Yes, for up to 20 cars.", question.Answer)
+					s.NotNil(question.AnsweredAt)
+				})
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			req := &request.AnswerServiceQuestionRequest{Answer: "Yes, for up to 20 cars."}
+			code := http.StatusOK
+			if apiError := s.usecase.AnswerQuestion(testCase.Claims, &model.ServiceQuestion{}, "1", "4", req); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *serviceQuestionUsecaseSuite) TestModerateQuestion() {
+	admin := &helper.JWTCustomClaims{ID: 1, Role: "admin"}
+
+	service := model.Service{Model: gorm.Model{ID: 1}, UserID: 2, Status: model.ServiceStatusPublished}
+
+	testCases := []struct {
+		Name         string
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, service)
+				s.serviceQuestionRepository.EXPECT().Find(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("4"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"ok",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1")).SetArg(0, service)
+				s.serviceQuestionRepository.EXPECT().Find(
+					gomock.Any(),
+					gomock.Eq(uint(1)),
+					gomock.Eq("4"),
+				).SetArg(0, model.ServiceQuestion{Model: gorm.Model{ID: 4}, Status: model.QuestionStatusPending})
+				s.serviceQuestionRepository.EXPECT().Save(gomock.Any()).Do(func(question *model.ServiceQuestion) {
+					s.Equal(model.QuestionStatusPublished, question.Status)
+				})
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			req := &request.ModerateServiceQuestionRequest{Status: model.QuestionStatusPublished}
+			code := http.StatusOK
+			if apiError := s.usecase.ModerateQuestion(admin, &model.ServiceQuestion{}, "1", "4", req); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+		})
+	}
+}
+
+func (s *serviceQuestionUsecaseSuite) TestModerateQuestionUnauthorized() {
+	claims := &helper.JWTCustomClaims{ID: 2, Role: "organizer"}
+
+	for _, apiError := range []helper.APIError{
+		s.usecase.ModerateQuestion(claims, &model.ServiceQuestion{}, "1", "4", &request.ModerateServiceQuestionRequest{}),
+	} {
+		s.NotNil(apiError)
+		code, _ := apiError.APIError()
+		s.Equal(http.StatusUnauthorized, code)
+	}
+}
@@ -1,55 +1,216 @@
 package usecase
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/andikabahari/eoplatform/helper"
 	"github.com/andikabahari/eoplatform/model"
 	r "github.com/andikabahari/eoplatform/repository"
 	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/search"
+	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/golang-jwt/jwt"
 	"github.com/labstack/echo/v4"
 )
 
 type ServiceUsecase interface {
-	GetServices(services *[]model.Service, keyword string)
+	GetServices(services *[]model.Service, facets *model.ServiceFacets, req *request.GetServicesRequest) helper.APIError
+	GetOwnServices(claims *helper.JWTCustomClaims, services *[]model.Service, req *request.GetOwnServicesRequest)
+	GetRevisions(claims *helper.JWTCustomClaims, revisions *[]model.ServiceRevision, id string) helper.APIError
 	FindService(service *model.Service, id string) helper.APIError
-	CreateService(claims *helper.JWTCustomClaims, service *model.Service, req *request.CreateServiceRequest)
+	CreateService(claims *helper.JWTCustomClaims, service *model.Service, req *request.CreateServiceRequest) helper.APIError
 	UpdateService(ctx echo.Context, service *model.Service, req *request.UpdateServiceRequest) helper.APIError
 	DeleteService(ctx echo.Context, service *model.Service) helper.APIError
+	ImportServices(ctx echo.Context, result *model.ServiceImport, req *request.ImportServicesRequest) helper.APIError
+	RebuildSearchIndex()
 }
 
 type serviceUsecase struct {
-	serviceRepository r.ServiceRepository
+	transactor                r.Transactor
+	serviceRepository         r.ServiceRepository
+	categoryRepository        r.CategoryRepository
+	tagRepository             r.TagRepository
+	favoriteRepository        r.FavoriteRepository
+	serviceRevisionRepository r.ServiceRevisionRepository
+	searchIndex               search.Index
 }
 
-func NewServiceUsecase(serviceRepository r.ServiceRepository) ServiceUsecase {
-	return &serviceUsecase{serviceRepository}
+func NewServiceUsecase(
+	transactor r.Transactor,
+	serviceRepository r.ServiceRepository,
+	categoryRepository r.CategoryRepository,
+	tagRepository r.TagRepository,
+	favoriteRepository r.FavoriteRepository,
+	serviceRevisionRepository r.ServiceRevisionRepository,
+	searchIndex search.Index,
+) ServiceUsecase {
+	return &serviceUsecase{
+		transactor,
+		serviceRepository,
+		categoryRepository,
+		tagRepository,
+		favoriteRepository,
+		serviceRevisionRepository,
+		searchIndex,
+	}
 }
 
-func (u *serviceUsecase) GetServices(services *[]model.Service, keyword string) {
-	u.serviceRepository.Get(services, keyword)
+const defaultServicesLimit = 20
+
+func (u *serviceUsecase) GetServices(services *[]model.Service, facets *model.ServiceFacets, req *request.GetServicesRequest) helper.APIError {
+	if req.MaxPrice > 0 && req.MaxPrice < req.MinPrice {
+		return helper.NewAPIError(http.StatusBadRequest, "max_price must not be less than min_price")
+	}
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 {
+		req.Limit = defaultServicesLimit
+	}
+
+	filter := model.ServiceFilter{
+		Status:      model.ServiceStatusPublished,
+		Keyword:     req.Keyword,
+		Tag:         strings.ToLower(strings.TrimSpace(req.Tag)),
+		MinPrice:    req.MinPrice,
+		MaxPrice:    req.MaxPrice,
+		OrganizerID: req.Organizer,
+		MinRating:   req.MinRating,
+		Offset:      (req.Page - 1) * req.Limit,
+		Limit:       req.Limit,
+	}
+
+	if req.AvailableOn != "" {
+		availableOn, err := time.Parse("2006-01-02", req.AvailableOn)
+		if err != nil {
+			return helper.NewAPIError(http.StatusBadRequest, "invalid available_on date")
+		}
+		filter.AvailableOn = &availableOn
+	}
+
+	if req.Category != "" {
+		category := model.Category{}
+		u.categoryRepository.FindBySlug(&category, req.Category)
+
+		filter.CategoryIDs = make([]uint, 0)
+		if category.ID > 0 {
+			categories := make([]model.Category, 0)
+			u.categoryRepository.Get(&categories)
+			filter.CategoryIDs = descendantCategoryIDs(categories, category.ID)
+		}
+	}
+
+	// The index ranks and tolerates typos, but only knows about services
+	// once it has been built; until then keywords go to the database.
+	hits := make(map[uint]search.Hit)
+	if req.Keyword != "" && u.searchIndex.Ready() {
+		filter.Keyword = ""
+		filter.IDs = make([]uint, 0)
+		for _, hit := range u.searchIndex.Search(req.Keyword) {
+			filter.IDs = append(filter.IDs, hit.ID)
+			hits[hit.ID] = hit
+		}
+
+		if req.Sort == "" {
+			req.Sort = model.ServiceSortRelevance
+		}
+	}
+
+	if req.Sort == "" || (req.Sort == model.ServiceSortRelevance && filter.IDs == nil) {
+		req.Sort = model.ServiceSortNewest
+	}
+	filter.Sort = req.Sort
+
+	u.serviceRepository.Get(services, &filter)
+	u.serviceRepository.GetFacets(facets, &filter)
+
+	for i := range *services {
+		(*services)[i].Highlights = hits[(*services)[i].ID].Highlights
+	}
+
+	return nil
+}
+
+func (u *serviceUsecase) GetOwnServices(claims *helper.JWTCustomClaims, services *[]model.Service, req *request.GetOwnServicesRequest) {
+	u.serviceRepository.Get(services, &model.ServiceFilter{
+		Status:      req.Status,
+		OrganizerID: claims.ID,
+		Sort:        model.ServiceSortNewest,
+	})
+	u.favoriteRepository.CountForServices(services)
+}
+
+func (u *serviceUsecase) GetRevisions(claims *helper.JWTCustomClaims, revisions *[]model.ServiceRevision, id string) helper.APIError {
+	service := model.Service{}
+	u.serviceRepository.Find(&service, id)
+
+	if service.ID == 0 {
+		return helper.NewAPIError(http.StatusNotFound, "service not found")
+	}
+
+	if service.UserID != claims.ID {
+		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	u.serviceRevisionRepository.Get(revisions, service.ID)
+
+	return nil
 }
 
 func (u *serviceUsecase) FindService(service *model.Service, id string) helper.APIError {
 	u.serviceRepository.Find(service, id)
 
-	if service.ID == 0 {
+	// Archived services stay visible so that past orders can link to them.
+	if service.ID == 0 || service.Status == model.ServiceStatusDraft {
 		return helper.NewAPIError(http.StatusNotFound, "service not found")
 	}
 
 	return nil
 }
 
-func (u *serviceUsecase) CreateService(claims *helper.JWTCustomClaims, service *model.Service, req *request.CreateServiceRequest) {
+func (u *serviceUsecase) CreateService(claims *helper.JWTCustomClaims, service *model.Service, req *request.CreateServiceRequest) helper.APIError {
+	if apiError := u.applyCategoryAndTags(service, &req.BasicService); apiError != nil {
+		return apiError
+	}
+
+	if apiError := applyVariants(service, req.Variants); apiError != nil {
+		return apiError
+	}
+
+	if apiError := applyAddons(service, req.Addons); apiError != nil {
+		return apiError
+	}
+
 	service.UserID = claims.ID
 	service.Name = req.Name
 	service.Cost = req.Cost
 	service.Phone = req.Phone
 	service.Email = req.Email
 	service.Description = req.Description
+	service.Status = req.Status
+	if service.Status == "" {
+		service.Status = model.ServiceStatusPublished
+	}
 
-	u.serviceRepository.Create(service)
+	if err := u.serviceRepository.Create(service); err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+	if err := u.recordRevision(*service, claims.ID); err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+	u.indexService(*service)
+
+	return nil
 }
 
 func (u *serviceUsecase) UpdateService(ctx echo.Context, service *model.Service, req *request.UpdateServiceRequest) helper.APIError {
@@ -66,7 +227,36 @@ func (u *serviceUsecase) UpdateService(ctx echo.Context, service *model.Service,
 		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	u.serviceRepository.Update(service, req)
+	if apiError := u.applyCategoryAndTags(service, &req.BasicService); apiError != nil {
+		return apiError
+	}
+
+	// Variants and add-ons left out of the update are kept as they are.
+	if req.Variants != nil {
+		if apiError := applyVariants(service, req.Variants); apiError != nil {
+			return apiError
+		}
+	}
+
+	if req.Addons != nil {
+		if apiError := applyAddons(service, req.Addons); apiError != nil {
+			return apiError
+		}
+	}
+
+	if req.Status != "" {
+		service.Status = req.Status
+	}
+
+	if err := u.serviceRepository.Update(service, req); err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+	if err := u.recordRevision(*service, claims.ID); err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+	u.indexService(*service)
 
 	return nil
 }
@@ -85,7 +275,394 @@ func (u *serviceUsecase) DeleteService(ctx echo.Context, service *model.Service)
 		return helper.NewAPIError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	u.serviceRepository.Delete(service)
+	// Services that have been ordered are archived instead, so the orders
+	// placed for them keep resolving.
+	if u.serviceRepository.CountOrders(service.ID) > 0 {
+		service.Status = model.ServiceStatusArchived
+		u.serviceRepository.UpdateStatus(service, service.Status)
+		if err := u.recordRevision(*service, claims.ID); err != nil {
+			log.Printf("Error: %s", err)
+			return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+		}
+	} else {
+		u.serviceRepository.Delete(service)
+	}
+	u.searchIndex.Delete(service.ID)
+
+	return nil
+}
+
+const (
+	maxServiceImportSize = 1 << 20
+	maxServiceImportRows = 500
+)
+
+// ImportServices creates or updates the organizer's services from an
+// uploaded CSV or JSON file, matching them by external ID. Every row is
+// validated first and nothing is saved unless all of them pass, or when
+// only a dry run was asked for. Rows without variants or add-ons keep the
+// ones the service already has.
+func (u *serviceUsecase) ImportServices(ctx echo.Context, result *model.ServiceImport, req *request.ImportServicesRequest) helper.APIError {
+	user := ctx.Get("user").(*jwt.Token)
+	claims := user.Claims.(*helper.JWTCustomClaims)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return helper.NewAPIError(http.StatusBadRequest, "file is required")
+	}
+
+	if fileHeader.Size > maxServiceImportSize {
+		return helper.NewAPIError(http.StatusBadRequest, "file must not exceed 1MB")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Printf("Error: %s", err)
+		return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+	}
+	defer file.Close()
+
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+	rows, err := request.ParseServiceImport(io.LimitReader(file, maxServiceImportSize), format)
+	if errors.Is(err, request.ErrUnsupportedImportFormat) {
+		return helper.NewAPIError(http.StatusBadRequest, "file must be a CSV or JSON file")
+	}
+	if err != nil {
+		return helper.NewAPIError(http.StatusBadRequest, fmt.Sprintf("file could not be read: %s", err))
+	}
+
+	if len(rows) == 0 {
+		return helper.NewAPIError(http.StatusBadRequest, "file has no services")
+	}
+	if len(rows) > maxServiceImportRows {
+		return helper.NewAPIError(http.StatusBadRequest, fmt.Sprintf("file must not have more than %d services", maxServiceImportRows))
+	}
+
+	result.DryRun = req.DryRun
+	result.Rows = make([]model.ServiceImportRow, 0, len(rows))
+	services := make([]model.Service, len(rows))
+	seen := make(map[string]int)
+	for i, row := range rows {
+		errs := u.checkImportRow(claims, &services[i], row)
+		if first, ok := seen[row.ExternalID]; ok && row.ExternalID != "" {
+			errs["external_id"] = fmt.Sprintf("duplicates row %d", first)
+		}
+		seen[row.ExternalID] = i + 1
+
+		line := model.ServiceImportRow{
+			Row:        i + 1,
+			ExternalID: row.ExternalID,
+			Action:     model.ServiceImportActionCreate,
+			ServiceID:  services[i].ID,
+		}
+		if services[i].ID > 0 {
+			line.Action = model.ServiceImportActionUpdate
+		}
+		if len(errs) > 0 {
+			line.Errors = errs
+		}
+		result.Rows = append(result.Rows, line)
+	}
+
+	if !result.Valid() {
+		return nil
+	}
+
+	for _, line := range result.Rows {
+		if line.Action == model.ServiceImportActionCreate {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+	}
+
+	if req.DryRun {
+		return nil
+	}
+
+	// The rows are saved in one transaction, so that a row failing to save
+	// leaves none of the others imported.
+	err = u.transactor.Transaction(func(tx r.Tx) error {
+		txUsecase := u.withTx(tx)
+
+		for i, row := range rows {
+			service := &services[i]
+			if apiError := txUsecase.applyCategoryAndTags(service, &row.BasicService); apiError != nil {
+				return apiError
+			}
+
+			if service.ID == 0 {
+				externalID := row.ExternalID
+				service.UserID = claims.ID
+				service.ExternalID = &externalID
+				service.Name = row.Name
+				service.Cost = row.Cost
+				service.Phone = row.Phone
+				service.Email = row.Email
+				service.Description = row.Description
+				service.Status = row.Status
+				if service.Status == "" {
+					service.Status = model.ServiceStatusPublished
+				}
+
+				if err := txUsecase.serviceRepository.Create(service); err != nil {
+					return err
+				}
+			} else {
+				if row.Status != "" {
+					service.Status = row.Status
+				}
+
+				if err := txUsecase.serviceRepository.Update(service, &request.UpdateServiceRequest{BasicService: row.BasicService}); err != nil {
+					return err
+				}
+			}
+
+			result.Rows[i].ServiceID = service.ID
+			if err := txUsecase.recordRevision(*service, claims.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+
+	for _, service := range services {
+		u.indexService(service)
+	}
+
+	return nil
+}
+
+// checkImportRow validates row and loads into service the organizer's
+// service it updates, if any, with the row's variants and add-ons applied.
+// The returned errors are keyed by field.
+func (u *serviceUsecase) checkImportRow(claims *helper.JWTCustomClaims, service *model.Service, row request.ImportServiceRow) map[string]string {
+	errs := make(map[string]string)
+	if err := row.Validate(); err != nil {
+		if fieldErrs, ok := err.(validation.Errors); ok {
+			for field, fieldErr := range fieldErrs {
+				errs[field] = fieldErr.Error()
+			}
+		} else {
+			errs["row"] = err.Error()
+		}
+	}
+
+	if row.ExternalID != "" {
+		u.serviceRepository.FindByExternalID(service, claims.ID, row.ExternalID)
+
+		// A service created without an external ID was exported under its
+		// ID instead.
+		id := strings.TrimPrefix(row.ExternalID, model.ServiceExportIDPrefix)
+		if service.ID == 0 && id != row.ExternalID {
+			u.serviceRepository.Find(service, id)
+			if service.UserID != claims.ID {
+				*service = model.Service{}
+				errs["external_id"] = "service not found"
+			}
+		}
+	}
+
+	if row.CategoryID != nil && errs["category_id"] == "" {
+		category := model.Category{}
+		u.categoryRepository.Find(&category, *row.CategoryID)
+		if category.ID == 0 {
+			errs["category_id"] = "category not found"
+		}
+	}
+
+	if row.Variants != nil && errs["variants"] == "" {
+		if apiError := applyVariants(service, row.Variants); apiError != nil {
+			_, errs["variants"] = apiError.APIError()
+		}
+	}
+
+	if row.Addons != nil && errs["addons"] == "" {
+		if apiError := applyAddons(service, row.Addons); apiError != nil {
+			_, errs["addons"] = apiError.APIError()
+		}
+	}
+
+	return errs
+}
+
+// recordRevision keeps a snapshot of service as edited by the user userID,
+// unless nothing changed since its latest revision.
+func (u *serviceUsecase) recordRevision(service model.Service, userID uint) error {
+	latest := model.ServiceRevision{}
+	u.serviceRevisionRepository.FindLatest(&latest, service.ID)
+
+	revision := model.NewServiceRevision(service, userID)
+	if latest.ID > 0 && len(revision.Changes(&latest)) == 0 {
+		return nil
+	}
+
+	revision.Number = latest.Number + 1
+
+	return u.serviceRevisionRepository.Create(&revision)
+}
+
+// withTx returns a copy of the usecase whose repositories write in tx.
+func (u *serviceUsecase) withTx(tx r.Tx) *serviceUsecase {
+	return &serviceUsecase{
+		u.transactor,
+		u.serviceRepository.WithTx(tx),
+		u.categoryRepository.WithTx(tx),
+		u.tagRepository.WithTx(tx),
+		u.favoriteRepository,
+		u.serviceRevisionRepository.WithTx(tx),
+		u.searchIndex,
+	}
+}
+
+func (u *serviceUsecase) RebuildSearchIndex() {
+	u.searchIndex.Rebuild(func() []search.Document {
+		services := make([]model.Service, 0)
+		u.serviceRepository.Get(&services, &model.ServiceFilter{Status: model.ServiceStatusPublished})
+
+		docs := make([]search.Document, 0, len(services))
+		for _, service := range services {
+			docs = append(docs, newSearchDocument(service))
+		}
+
+		return docs
+	})
+}
+
+// indexService makes the service searchable while it is published and
+// removes it from the index otherwise.
+func (u *serviceUsecase) indexService(service model.Service) {
+	if service.Status != model.ServiceStatusPublished {
+		u.searchIndex.Delete(service.ID)
+		return
+	}
+
+	u.searchIndex.Put(newSearchDocument(service))
+}
+
+func newSearchDocument(service model.Service) search.Document {
+	tags := make([]string, 0, len(service.Tags))
+	for _, tag := range service.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	return search.Document{
+		ID: service.ID,
+		Fields: map[string]string{
+			"name":        service.Name,
+			"description": service.Description,
+			"category":    service.Category.Name,
+			"tags":        strings.Join(tags, " "),
+		},
+	}
+}
+
+func (u *serviceUsecase) applyCategoryAndTags(service *model.Service, req *request.BasicService) helper.APIError {
+	service.CategoryID = nil
+	service.Category = model.Category{}
+	if req.CategoryID != nil {
+		u.categoryRepository.Find(&service.Category, *req.CategoryID)
+
+		if service.Category.ID == 0 {
+			return helper.NewAPIError(http.StatusBadRequest, "category not found")
+		}
+		service.CategoryID = &service.Category.ID
+	}
+
+	tags := make([]model.Tag, 0)
+	seen := make(map[string]bool)
+	for _, name := range req.Tags {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		tag := model.Tag{}
+		if err := u.tagRepository.FirstOrCreate(&tag, name); err != nil {
+			log.Printf("Error: %s", err)
+			return helper.NewAPIError(http.StatusInternalServerError, "internal server error")
+		}
+		tags = append(tags, tag)
+	}
+	service.Tags = tags
 
 	return nil
 }
+
+// applyVariants replaces the variants of the service with the requested
+// ones. Variants with an ID are updated in place and must already belong to
+// the service.
+func applyVariants(service *model.Service, reqs []request.Variant) helper.APIError {
+	existing := make(map[uint]model.ServiceVariant)
+	for _, variant := range service.Variants {
+		existing[variant.ID] = variant
+	}
+
+	variants := make([]model.ServiceVariant, 0, len(reqs))
+	for _, req := range reqs {
+		variant := model.ServiceVariant{}
+		if req.ID > 0 {
+			var ok bool
+			if variant, ok = existing[req.ID]; !ok {
+				return helper.NewAPIError(http.StatusBadRequest, "variant not found")
+			}
+		}
+
+		variant.Name = req.Name
+		variant.Price = req.Price
+		variant.Description = req.Description
+		variant.Items = req.Items
+		variants = append(variants, variant)
+	}
+	service.Variants = variants
+
+	return nil
+}
+
+// applyAddons replaces the add-ons of the service with the requested ones,
+// the same way applyVariants does for variants.
+func applyAddons(service *model.Service, reqs []request.Addon) helper.APIError {
+	existing := make(map[uint]model.ServiceAddon)
+	for _, addon := range service.Addons {
+		existing[addon.ID] = addon
+	}
+
+	addons := make([]model.ServiceAddon, 0, len(reqs))
+	for _, req := range reqs {
+		addon := model.ServiceAddon{}
+		if req.ID > 0 {
+			var ok bool
+			if addon, ok = existing[req.ID]; !ok {
+				return helper.NewAPIError(http.StatusBadRequest, "add-on not found")
+			}
+		}
+
+		addon.Name = req.Name
+		addon.Price = req.Price
+		addon.MaxQuantity = req.MaxQuantity
+		addons = append(addons, addon)
+	}
+	service.Addons = addons
+
+	return nil
+}
+
+// descendantCategoryIDs returns the given category together with every
+// category below it in the tree.
+func descendantCategoryIDs(categories []model.Category, id uint) []uint {
+	ids := []uint{id}
+	for i := 0; i < len(ids); i++ {
+		for _, category := range categories {
+			if category.ParentID != nil && *category.ParentID == ids[i] {
+				ids = append(ids, category.ID)
+			}
+		}
+	}
+
+	return ids
+}
@@ -1,15 +1,23 @@
 package usecase
 
 import (
+	"bytes"
+	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/andikabahari/eoplatform/helper"
 	"github.com/andikabahari/eoplatform/model"
+	r "github.com/andikabahari/eoplatform/repository"
 	mr "github.com/andikabahari/eoplatform/repository/mock_repository"
 	"github.com/andikabahari/eoplatform/request"
+	"github.com/andikabahari/eoplatform/response"
+	"github.com/andikabahari/eoplatform/search"
+	msearch "github.com/andikabahari/eoplatform/search/mock_search"
 	"github.com/golang-jwt/jwt"
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
@@ -20,8 +28,14 @@ import (
 type serviceUsecaseSuite struct {
 	suite.Suite
 
-	ctrl              *gomock.Controller
-	serviceRepository *mr.MockServiceRepository
+	ctrl                      *gomock.Controller
+	transactor                *mr.MockTransactor
+	serviceRepository         *mr.MockServiceRepository
+	categoryRepository        *mr.MockCategoryRepository
+	tagRepository             *mr.MockTagRepository
+	favoriteRepository        *mr.MockFavoriteRepository
+	serviceRevisionRepository *mr.MockServiceRevisionRepository
+	searchIndex               *msearch.MockIndex
 
 	usecase ServiceUsecase
 }
@@ -30,9 +44,15 @@ func (s *serviceUsecaseSuite) SetupSuite() {
 	os.Setenv("APP_ENV", "production")
 
 	s.ctrl = gomock.NewController(s.T())
+	s.transactor = mr.NewMockTransactor(s.ctrl)
 	s.serviceRepository = mr.NewMockServiceRepository(s.ctrl)
+	s.categoryRepository = mr.NewMockCategoryRepository(s.ctrl)
+	s.tagRepository = mr.NewMockTagRepository(s.ctrl)
+	s.favoriteRepository = mr.NewMockFavoriteRepository(s.ctrl)
+	s.serviceRevisionRepository = mr.NewMockServiceRevisionRepository(s.ctrl)
+	s.searchIndex = msearch.NewMockIndex(s.ctrl)
 
-	s.usecase = NewServiceUsecase(s.serviceRepository)
+	s.usecase = NewServiceUsecase(s.transactor, s.serviceRepository, s.categoryRepository, s.tagRepository, s.favoriteRepository, s.serviceRevisionRepository, s.searchIndex)
 }
 
 func (s *serviceUsecaseSuite) TearDownSuite() {
@@ -43,32 +63,253 @@ func TestServiceUsecaseSuite(t *testing.T) {
 	suite.Run(t, new(serviceUsecaseSuite))
 }
 
+// expectTransaction runs the usecase's transaction with the suite's mocks
+// bound to it.
+func (s *serviceUsecaseSuite) expectTransaction() {
+	s.transactor.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fn func(tx r.Tx) error) error {
+		return fn(r.Tx{})
+	})
+	s.serviceRepository.EXPECT().WithTx(gomock.Any()).Return(s.serviceRepository)
+	s.categoryRepository.EXPECT().WithTx(gomock.Any()).Return(s.categoryRepository)
+	s.tagRepository.EXPECT().WithTx(gomock.Any()).Return(s.tagRepository)
+	s.serviceRevisionRepository.EXPECT().WithTx(gomock.Any()).Return(s.serviceRevisionRepository)
+}
+
 func (s *serviceUsecaseSuite) TestGetServices() {
+	parentID := uint(1)
+
 	testCases := []struct {
 		Name         string
-		Body         any
+		Body         *request.GetServicesRequest
 		Claims       *helper.JWTCustomClaims
 		ExpectedFunc func()
 		ExpectedCode int
 	}{
 		{
 			"ok",
+			&request.GetServicesRequest{},
+			nil,
+			func() {
+				filter := &model.ServiceFilter{Status: model.ServiceStatusPublished, Sort: model.ServiceSortNewest, Limit: 20}
+				s.serviceRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Service{}),
+					gomock.Eq(filter),
+				)
+				s.serviceRepository.EXPECT().GetFacets(
+					gomock.Eq(&model.ServiceFacets{}),
+					gomock.Eq(filter),
+				)
+			},
+			http.StatusOK,
+		},
+		{
+			"unknown category",
+			&request.GetServicesRequest{Category: "unknown"},
+			nil,
+			func() {
+				s.categoryRepository.EXPECT().FindBySlug(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("unknown"),
+				)
+
+				filter := &model.ServiceFilter{Status: model.ServiceStatusPublished, CategoryIDs: []uint{}, Sort: model.ServiceSortNewest, Limit: 20}
+				s.serviceRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Service{}),
+					gomock.Eq(filter),
+				)
+				s.serviceRepository.EXPECT().GetFacets(
+					gomock.Eq(&model.ServiceFacets{}),
+					gomock.Eq(filter),
+				)
+			},
+			http.StatusOK,
+		},
+		{
+			"category and tag",
+			&request.GetServicesRequest{Keyword: "wedding", Category: "venue", Tag: " Outdoor "},
 			nil,
+			func() {
+				s.searchIndex.EXPECT().Ready().Return(false)
+
+				s.categoryRepository.EXPECT().FindBySlug(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq("venue"),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}, Slug: "venue"})
+
+				s.categoryRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Category{}),
+				).SetArg(0, []model.Category{
+					{Model: gorm.Model{ID: 1}, Slug: "venue"},
+					{Model: gorm.Model{ID: 2}, Slug: "ballroom", ParentID: &parentID},
+					{Model: gorm.Model{ID: 3}, Slug: "catering"},
+				})
+
+				filter := &model.ServiceFilter{
+					Status:      model.ServiceStatusPublished,
+					Keyword:     "wedding",
+					CategoryIDs: []uint{1, 2},
+					Tag:         "outdoor",
+					Sort:        model.ServiceSortNewest,
+					Limit:       20,
+				}
+				s.serviceRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Service{}),
+					gomock.Eq(filter),
+				)
+				s.serviceRepository.EXPECT().GetFacets(
+					gomock.Eq(&model.ServiceFacets{}),
+					gomock.Eq(filter),
+				)
+			},
+			http.StatusOK,
+		},
+		{
+			"price, rating, availability and paging",
+			&request.GetServicesRequest{
+				MinPrice:    100000,
+				MaxPrice:    500000,
+				Organizer:   2,
+				MinRating:   4,
+				AvailableOn: "2024-05-01",
+				Sort:        model.ServiceSortPriceAsc,
+				Page:        3,
+				Limit:       10,
+			},
 			nil,
 			func() {
+				availableOn := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+				filter := &model.ServiceFilter{
+					Status:      model.ServiceStatusPublished,
+					MinPrice:    100000,
+					MaxPrice:    500000,
+					OrganizerID: 2,
+					MinRating:   4,
+					AvailableOn: &availableOn,
+					Sort:        model.ServiceSortPriceAsc,
+					Offset:      20,
+					Limit:       10,
+				}
 				s.serviceRepository.EXPECT().Get(
 					gomock.Eq(&[]model.Service{}),
-					gomock.Eq(""),
+					gomock.Eq(filter),
+				)
+				s.serviceRepository.EXPECT().GetFacets(
+					gomock.Eq(&model.ServiceFacets{}),
+					gomock.Eq(filter),
 				)
 			},
 			http.StatusOK,
 		},
+		{
+			"search index",
+			&request.GetServicesRequest{Keyword: "weding"},
+			nil,
+			func() {
+				s.searchIndex.EXPECT().Ready().Return(true)
+				s.searchIndex.EXPECT().Search(gomock.Eq("weding")).Return([]search.Hit{
+					{ID: 3, Highlights: map[string]string{"name": "<mark>Wedding</mark> Venue"}},
+					{ID: 1},
+				})
+
+				filter := &model.ServiceFilter{Status: model.ServiceStatusPublished, IDs: []uint{3, 1}, Sort: model.ServiceSortRelevance, Limit: 20}
+				s.serviceRepository.EXPECT().Get(
+					gomock.Eq(&[]model.Service{}),
+					gomock.Eq(filter),
+				).SetArg(0, []model.Service{{Model: gorm.Model{ID: 3}}, {Model: gorm.Model{ID: 1}}})
+				s.serviceRepository.EXPECT().GetFacets(
+					gomock.Eq(&model.ServiceFacets{}),
+					gomock.Eq(filter),
+				)
+			},
+			http.StatusOK,
+		},
+		{
+			"price range reversed",
+			&request.GetServicesRequest{MinPrice: 500000, MaxPrice: 100000},
+			nil,
+			func() {},
+			http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+			code := http.StatusOK
+			services := make([]model.Service, 0)
+			if apiError := s.usecase.GetServices(&services, &model.ServiceFacets{}, testCase.Body); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+			if len(services) > 0 {
+				s.Equal("<mark>Wedding</mark> Venue", services[0].Highlights["name"])
+			}
+		})
+	}
+}
+
+func (s *serviceUsecaseSuite) TestGetOwnServices() {
+	s.serviceRepository.EXPECT().Get(
+		gomock.Eq(&[]model.Service{}),
+		gomock.Eq(&model.ServiceFilter{
+			Status:      model.ServiceStatusDraft,
+			OrganizerID: 1,
+			Sort:        model.ServiceSortNewest,
+		}),
+	)
+	s.favoriteRepository.EXPECT().CountForServices(gomock.Eq(&[]model.Service{}))
+
+	claims := &helper.JWTCustomClaims{ID: 1, Role: "organizer"}
+	s.usecase.GetOwnServices(claims, &[]model.Service{}, &request.GetOwnServicesRequest{Status: model.ServiceStatusDraft})
+}
+
+func (s *serviceUsecaseSuite) TestGetRevisions() {
+	testCases := []struct {
+		Name         string
+		ExpectedFunc func()
+		ExpectedCode int
+	}{
+		{
+			"not found",
+			func() {
+				s.serviceRepository.EXPECT().Find(gomock.Eq(&model.Service{}), gomock.Eq("1"))
+			},
+			http.StatusNotFound,
+		},
+		{
+			"unauthorized",
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, UserID: 3})
+			},
+			http.StatusUnauthorized,
+		},
+		{
+			"ok",
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, UserID: 2})
+
+				s.serviceRevisionRepository.EXPECT().Get(gomock.Eq(&[]model.ServiceRevision{}), gomock.Eq(uint(1)))
+			},
+			http.StatusOK,
+		},
 	}
 
 	for _, testCase := range testCases {
 		s.T().Run(testCase.Name, func(t *testing.T) {
 			testCase.ExpectedFunc()
-			s.usecase.GetServices(&[]model.Service{}, "")
+
+			claims := &helper.JWTCustomClaims{ID: 2, Role: "organizer"}
+			code := http.StatusOK
+			if apiError := s.usecase.GetRevisions(claims, &[]model.ServiceRevision{}, "1"); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
 		})
 	}
 }
@@ -105,6 +346,30 @@ func (s *serviceUsecaseSuite) TestFindService() {
 			},
 			http.StatusOK,
 		},
+		{
+			"draft",
+			nil,
+			nil,
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusDraft})
+			},
+			http.StatusNotFound,
+		},
+		{
+			"archived",
+			nil,
+			nil,
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, Status: model.ServiceStatusArchived})
+			},
+			http.StatusOK,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -119,6 +384,8 @@ func (s *serviceUsecaseSuite) TestFindService() {
 }
 
 func (s *serviceUsecaseSuite) TestCreateService() {
+	categoryID := uint(1)
+
 	testCases := []struct {
 		Name         string
 		Body         *request.CreateServiceRequest
@@ -138,8 +405,97 @@ func (s *serviceUsecaseSuite) TestCreateService() {
 				},
 			},
 			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.serviceRepository.EXPECT().Create(gomock.Any()).Do(func(service *model.Service) {
+					s.Equal(model.ServiceStatusPublished, service.Status)
+				})
+				s.serviceRevisionRepository.EXPECT().FindLatest(gomock.Eq(&model.ServiceRevision{}), gomock.Any())
+				s.serviceRevisionRepository.EXPECT().Create(gomock.Any()).Do(func(revision *model.ServiceRevision) {
+					s.Equal(1, revision.Number)
+					s.Equal(uint(1), revision.UserID)
+					s.Equal("Service", revision.Name)
+				})
+				s.searchIndex.EXPECT().Put(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"ok published",
+			&request.CreateServiceRequest{
+				BasicService: request.BasicService{
+					Name:        "Service",
+					Cost:        1000000,
+					Phone:       "08123456789",
+					Email:       "user@example.com",
+					Description: "Lorem ipsum",
+					Status:      model.ServiceStatusPublished,
+				},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
 			func() {
 				s.serviceRepository.EXPECT().Create(gomock.Any())
+				s.serviceRevisionRepository.EXPECT().FindLatest(gomock.Any(), gomock.Eq(uint(0)))
+				s.serviceRevisionRepository.EXPECT().Create(gomock.Any())
+				s.searchIndex.EXPECT().Put(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"bad request",
+			&request.CreateServiceRequest{
+				BasicService: request.BasicService{
+					Name:        "Service",
+					Cost:        1000000,
+					Phone:       "08123456789",
+					Email:       "user@example.com",
+					Description: "Lorem ipsum",
+					CategoryID:  &categoryID,
+				},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq(categoryID),
+				)
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok",
+			&request.CreateServiceRequest{
+				BasicService: request.BasicService{
+					Name:        "Service",
+					Cost:        1000000,
+					Phone:       "08123456789",
+					Email:       "user@example.com",
+					Description: "Lorem ipsum",
+					CategoryID:  &categoryID,
+					Tags:        []string{"Outdoor", "outdoor", " "},
+					Status:      model.ServiceStatusPublished,
+				},
+			},
+			&helper.JWTCustomClaims{ID: 1, Role: "organizer"},
+			func() {
+				s.categoryRepository.EXPECT().Find(
+					gomock.Eq(&model.Category{}),
+					gomock.Eq(categoryID),
+				).SetArg(0, model.Category{Model: gorm.Model{ID: 1}})
+
+				s.tagRepository.EXPECT().FirstOrCreate(
+					gomock.Eq(&model.Tag{}),
+					gomock.Eq("outdoor"),
+				).SetArg(0, model.Tag{Model: gorm.Model{ID: 1}, Name: "outdoor"})
+
+				s.serviceRepository.EXPECT().Create(gomock.Any()).Do(func(service *model.Service) {
+					s.Equal(uint(1), *service.CategoryID)
+					s.Len(service.Tags, 1)
+				})
+				s.serviceRevisionRepository.EXPECT().FindLatest(gomock.Any(), gomock.Eq(uint(0)))
+				s.serviceRevisionRepository.EXPECT().Create(gomock.Any())
+				s.searchIndex.EXPECT().Put(gomock.Any()).Do(func(doc search.Document) {
+					s.Equal("outdoor", doc.Fields["tags"])
+				})
 			},
 			http.StatusOK,
 		},
@@ -148,7 +504,11 @@ func (s *serviceUsecaseSuite) TestCreateService() {
 	for _, testCase := range testCases {
 		s.T().Run(testCase.Name, func(t *testing.T) {
 			testCase.ExpectedFunc()
-			s.usecase.CreateService(testCase.Claims, &model.Service{}, testCase.Body)
+			code := http.StatusOK
+			if apiError := s.usecase.CreateService(testCase.Claims, &model.Service{}, testCase.Body); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
 		})
 	}
 }
@@ -236,9 +596,130 @@ func (s *serviceUsecaseSuite) TestUpdateService() {
 				s.serviceRepository.EXPECT().Find(
 					gomock.Eq(&model.Service{}),
 					gomock.Eq("1"),
-				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, UserID: 2})
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, UserID: 2, Status: model.ServiceStatusPublished})
 
 				s.serviceRepository.EXPECT().Update(gomock.Any(), gomock.Any())
+				s.serviceRevisionRepository.EXPECT().FindLatest(
+					gomock.Eq(&model.ServiceRevision{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.ServiceRevision{Model: gorm.Model{ID: 5}, ServiceID: 1, Number: 3, Status: model.ServiceStatusPublished})
+				s.searchIndex.EXPECT().Put(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"ok without variants and add-ons",
+			&request.UpdateServiceRequest{
+				BasicService: request.BasicService{
+					Name:        "Service",
+					Cost:        1000000,
+					Phone:       "08123456789",
+					Email:       "user@example.com",
+					Description: "Lorem ipsum",
+				},
+			},
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 2},
+			)),
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{
+					Model:    gorm.Model{ID: 1},
+					UserID:   2,
+					Status:   model.ServiceStatusPublished,
+					Variants: []model.ServiceVariant{{Model: gorm.Model{ID: 5}, ServiceID: 1, Name: "Silver", Price: 1500000}},
+					Addons:   []model.ServiceAddon{{Model: gorm.Model{ID: 6}, ServiceID: 1, Name: "Extra hour", Price: 250000, MaxQuantity: 3}},
+				})
+
+				s.serviceRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Do(func(service *model.Service, req *request.UpdateServiceRequest) {
+					s.Len(service.Variants, 1)
+					s.Equal("Silver", service.Variants[0].Name)
+					s.Len(service.Addons, 1)
+					s.Equal("Extra hour", service.Addons[0].Name)
+				})
+				s.serviceRevisionRepository.EXPECT().FindLatest(
+					gomock.Eq(&model.ServiceRevision{}),
+					gomock.Eq(uint(1)),
+				).SetArg(0, model.ServiceRevision{Model: gorm.Model{ID: 5}, ServiceID: 1, Number: 3, Status: model.ServiceStatusPublished})
+				s.serviceRevisionRepository.EXPECT().Create(gomock.Any()).Do(func(revision *model.ServiceRevision) {
+					s.Equal(4, revision.Number)
+					s.Equal([]model.RevisionVariant{{ID: 5, Name: "Silver", Price: 1500000}}, revision.Variants)
+					s.Equal([]model.RevisionAddon{{ID: 6, Name: "Extra hour", Price: 250000, MaxQuantity: 3}}, revision.Addons)
+				})
+				s.searchIndex.EXPECT().Put(gomock.Any())
+			},
+			http.StatusOK,
+		},
+		{
+			"variant not found",
+			&request.UpdateServiceRequest{
+				BasicService: request.BasicService{
+					Name:        "Service",
+					Cost:        1000000,
+					Phone:       "08123456789",
+					Email:       "user@example.com",
+					Description: "Lorem ipsum",
+					Variants:    []request.Variant{{ID: 5, Name: "Gold", Price: 2000000}},
+				},
+			},
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 2},
+			)),
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, UserID: 2})
+			},
+			http.StatusBadRequest,
+		},
+		{
+			"ok with variants and add-ons",
+			&request.UpdateServiceRequest{
+				BasicService: request.BasicService{
+					Name:        "Service",
+					Cost:        1000000,
+					Phone:       "08123456789",
+					Email:       "user@example.com",
+					Description: "Lorem ipsum",
+					Variants: []request.Variant{
+						{ID: 5, Name: "Gold", Price: 2000000},
+						{Name: "Platinum", Price: 3000000, Items: []string{"Venue"}},
+					},
+					Addons: []request.Addon{{Name: "Extra hour", Price: 250000, MaxQuantity: 3}},
+					Status: model.ServiceStatusArchived,
+				},
+			},
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 2},
+			)),
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{
+					Model:    gorm.Model{ID: 1},
+					UserID:   2,
+					Variants: []model.ServiceVariant{{Model: gorm.Model{ID: 5}, ServiceID: 1, Name: "Silver"}},
+				})
+
+				s.serviceRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Do(func(service *model.Service, req *request.UpdateServiceRequest) {
+					s.Len(service.Addons, 1)
+					s.Equal(3, service.Addons[0].MaxQuantity)
+					s.Len(service.Variants, 2)
+					s.Equal(uint(5), service.Variants[0].ID)
+					s.Equal("Gold", service.Variants[0].Name)
+					s.Equal(uint(0), service.Variants[1].ID)
+					s.Equal(model.ServiceStatusArchived, service.Status)
+				})
+				s.serviceRevisionRepository.EXPECT().FindLatest(gomock.Any(), gomock.Eq(uint(1)))
+				s.serviceRevisionRepository.EXPECT().Create(gomock.Any())
+				s.searchIndex.EXPECT().Delete(gomock.Eq(uint(1)))
 			},
 			http.StatusOK,
 		},
@@ -316,7 +797,30 @@ func (s *serviceUsecaseSuite) TestDeleteService() {
 					gomock.Eq("1"),
 				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, UserID: 1})
 
+				s.serviceRepository.EXPECT().CountOrders(gomock.Eq(uint(1))).Return(int64(0))
 				s.serviceRepository.EXPECT().Delete(gomock.Any())
+				s.searchIndex.EXPECT().Delete(gomock.Eq(uint(1)))
+			},
+			http.StatusOK,
+		},
+		{
+			"ok archived",
+			nil,
+			createContext(jwt.NewWithClaims(
+				jwt.SigningMethodHS256,
+				&helper.JWTCustomClaims{ID: 1},
+			)),
+			func() {
+				s.serviceRepository.EXPECT().Find(
+					gomock.Eq(&model.Service{}),
+					gomock.Eq("1"),
+				).SetArg(0, model.Service{Model: gorm.Model{ID: 1}, UserID: 1})
+
+				s.serviceRepository.EXPECT().CountOrders(gomock.Eq(uint(1))).Return(int64(3))
+				s.serviceRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Eq(model.ServiceStatusArchived))
+				s.serviceRevisionRepository.EXPECT().FindLatest(gomock.Any(), gomock.Eq(uint(1)))
+				s.serviceRevisionRepository.EXPECT().Create(gomock.Any())
+				s.searchIndex.EXPECT().Delete(gomock.Eq(uint(1)))
 			},
 			http.StatusOK,
 		},
@@ -332,3 +836,222 @@ func (s *serviceUsecaseSuite) TestDeleteService() {
 		})
 	}
 }
+
+func (s *serviceUsecaseSuite) TestImportServices() {
+	createContext := func(filename, content string) echo.Context {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		if filename != "" {
+			part, _ := writer.CreateFormFile("file", filename)
+			part.Write([]byte(content))
+		}
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.Set("user", jwt.NewWithClaims(jwt.SigningMethodHS256, &helper.JWTCustomClaims{ID: 1, Role: "organizer"}))
+		return ctx
+	}
+
+	header := "external_id,name,cost,phone,email,description,category_id,tags,status\n"
+	existing := model.Service{Model: gorm.Model{ID: 5}, UserID: 1, Name: "Old", Status: model.ServiceStatusPublished}
+
+	// An export is imported again as it was, one service having been
+	// created through the API without an external ID.
+	externalID := "B"
+	exported := []model.Service{
+		{Model: gorm.Model{ID: 8}, UserID: 1, Name: "Venue", Cost: 1000000, Phone: "0812", Email: "venue@example.com", Description: "Garden venue", Status: model.ServiceStatusPublished},
+		{Model: gorm.Model{ID: 9}, UserID: 1, ExternalID: &externalID, Name: "Catering", Cost: 500000, Phone: "0813", Email: "catering@example.com", Description: "Buffet", Status: model.ServiceStatusDraft},
+	}
+	export, err := response.NewServiceExportCSV(exported)
+	s.NoError(err)
+
+	testCases := []struct {
+		Name            string
+		Context         echo.Context
+		DryRun          bool
+		ExpectedFunc    func()
+		ExpectedCode    int
+		ExpectedValid   bool
+		ExpectedCreated int
+		ExpectedUpdated int
+	}{
+		{
+			"no file",
+			createContext("", ""),
+			false,
+			func() {},
+			http.StatusBadRequest,
+			false,
+			0,
+			0,
+		},
+		{
+			"unsupported format",
+			createContext("services.xlsx", "data"),
+			false,
+			func() {},
+			http.StatusBadRequest,
+			false,
+			0,
+			0,
+		},
+		{
+			"unknown column",
+			createContext("services.csv", "external_id,price\nA,1000\n"),
+			false,
+			func() {},
+			http.StatusBadRequest,
+			false,
+			0,
+			0,
+		},
+		{
+			"invalid rows",
+			createContext("services.csv", header+
+				"A,Venue,1000000,0812,venue@example.com,Garden venue,,,\n"+
+				"A,Catering,abc,0813,catering@example.com,Buffet,,,\n"),
+			false,
+			func() {
+				s.serviceRepository.EXPECT().FindByExternalID(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("A")).Times(2)
+			},
+			http.StatusOK,
+			false,
+			0,
+			0,
+		},
+		{
+			"dry run",
+			createContext("services.csv", header+
+				"A,Venue,1000000,0812,venue@example.com,Garden venue,,outdoor|garden,published\n"+
+				"B,Catering,500000,0813,catering@example.com,Buffet,2,,\n"),
+			true,
+			func() {
+				s.serviceRepository.EXPECT().FindByExternalID(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("A")).SetArg(0, existing)
+				s.serviceRepository.EXPECT().FindByExternalID(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("B"))
+				s.categoryRepository.EXPECT().Find(gomock.Any(), gomock.Eq(uint(2))).SetArg(0, model.Category{Model: gorm.Model{ID: 2}})
+			},
+			http.StatusOK,
+			true,
+			1,
+			1,
+		},
+		{
+			"export round trip",
+			createContext("services.csv", string(export)),
+			true,
+			func() {
+				s.serviceRepository.EXPECT().FindByExternalID(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("#8"))
+				s.serviceRepository.EXPECT().Find(gomock.Any(), gomock.Eq("8")).SetArg(0, exported[0])
+				s.serviceRepository.EXPECT().FindByExternalID(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("B")).SetArg(0, exported[1])
+			},
+			http.StatusOK,
+			true,
+			0,
+			2,
+		},
+		{
+			"another organizer's service",
+			createContext("services.csv", header+"#10,Venue,1000000,0812,venue@example.com,Garden venue,,,\n"),
+			true,
+			func() {
+				s.serviceRepository.EXPECT().FindByExternalID(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("#10"))
+				s.serviceRepository.EXPECT().Find(gomock.Any(), gomock.Eq("10")).SetArg(0, model.Service{Model: gorm.Model{ID: 10}, UserID: 2})
+			},
+			http.StatusOK,
+			false,
+			0,
+			0,
+		},
+		{
+			"rolled back",
+			createContext("services.csv", header+
+				"A,Venue,1000000,0812,venue@example.com,Garden venue,,,\n"+
+				"B,Catering,500000,0813,catering@example.com,Buffet,,,\n"),
+			false,
+			func() {
+				s.serviceRepository.EXPECT().FindByExternalID(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("A"))
+				s.serviceRepository.EXPECT().FindByExternalID(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("B"))
+				s.expectTransaction()
+				s.serviceRepository.EXPECT().Create(gomock.Any()).Do(func(service *model.Service) {
+					service.ID = 6
+				})
+				s.serviceRevisionRepository.EXPECT().FindLatest(gomock.Any(), gomock.Eq(uint(6)))
+				s.serviceRevisionRepository.EXPECT().Create(gomock.Any())
+				s.serviceRepository.EXPECT().Create(gomock.Any()).Return(errors.New("connection refused"))
+			},
+			http.StatusInternalServerError,
+			false,
+			0,
+			0,
+		},
+		{
+			"ok",
+			createContext("services.json", `[{"external_id":"A","name":"Venue","cost":1000000,"phone":"0812",`+
+				`"email":"venue@example.com","description":"Garden venue","tags":["outdoor"],`+
+				`"variants":[{"name":"Gold","price":2000000}]}]`),
+			false,
+			func() {
+				s.serviceRepository.EXPECT().FindByExternalID(gomock.Any(), gomock.Eq(uint(1)), gomock.Eq("A"))
+				s.expectTransaction()
+				s.tagRepository.EXPECT().FirstOrCreate(gomock.Any(), gomock.Eq("outdoor"))
+				s.serviceRepository.EXPECT().Create(gomock.Any()).Do(func(service *model.Service) {
+					s.Equal("A", *service.ExternalID)
+					s.Equal(model.ServiceStatusPublished, service.Status)
+					s.Len(service.Variants, 1)
+					service.ID = 6
+				})
+				s.serviceRevisionRepository.EXPECT().FindLatest(gomock.Any(), gomock.Eq(uint(6)))
+				s.serviceRevisionRepository.EXPECT().Create(gomock.Any())
+				s.searchIndex.EXPECT().Put(gomock.Any())
+			},
+			http.StatusOK,
+			true,
+			1,
+			0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			testCase.ExpectedFunc()
+
+			result := model.ServiceImport{}
+			code := http.StatusOK
+			if apiError := s.usecase.ImportServices(testCase.Context, &result, &request.ImportServicesRequest{DryRun: testCase.DryRun}); apiError != nil {
+				code, _ = apiError.APIError()
+			}
+			s.Equal(testCase.ExpectedCode, code)
+
+			if code == http.StatusOK {
+				s.Equal(testCase.ExpectedValid, result.Valid())
+				s.Equal(testCase.ExpectedCreated, result.Created)
+				s.Equal(testCase.ExpectedUpdated, result.Updated)
+			}
+		})
+	}
+}
+
+func (s *serviceUsecaseSuite) TestRebuildSearchIndex() {
+	s.searchIndex.EXPECT().Rebuild(gomock.Any()).Do(func(load func() []search.Document) {
+		s.serviceRepository.EXPECT().Get(
+			gomock.Eq(&[]model.Service{}),
+			gomock.Eq(&model.ServiceFilter{Status: model.ServiceStatusPublished}),
+		).SetArg(0, []model.Service{{
+			Model:    gorm.Model{ID: 1},
+			Name:     "Wedding Venue",
+			Category: model.Category{Name: "Venue"},
+			Tags:     []model.Tag{{Name: "outdoor"}, {Name: "garden"}},
+		}})
+
+		docs := load()
+		s.Len(docs, 1)
+		s.Equal(uint(1), docs[0].ID)
+		s.Equal("Venue", docs[0].Fields["category"])
+		s.Equal("outdoor garden", docs[0].Fields["tags"])
+	})
+
+	s.usecase.RebuildSearchIndex()
+}